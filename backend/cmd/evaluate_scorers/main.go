@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/sukhmai/spotify-match/pkg/db"
+	"github.com/sukhmai/spotify-match/pkg/matching"
+)
+
+func main() {
+	roundIDs := flag.String("rounds", "", "Comma-separated IDs of the rounds to replay (defaults to every matched round)")
+	genreWeight := flag.Float64("genre-weight", -1, "Share (0-1) of the similarity from genre overlap, instead of each round's setting")
+	steepness := flag.Float64("steepness", matching.DefaultScoreCurve.Steepness, "Steepness of the match score curve used for calibration")
+	midpoint := flag.Float64("midpoint", matching.DefaultScoreCurve.Midpoint, "Similarity that maps to a match score of 50 in the calibration")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	dbClient, err := db.NewClientFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer dbClient.Close()
+
+	rounds, err := replayRounds(ctx, dbClient, *roundIDs)
+	if err != nil {
+		log.Fatalf("Failed to get rounds: %v", err)
+	}
+
+	// Outcomes are scored per round, since the IDF strategies depend on the
+	// round's cohort, and pooled across rounds for the evaluation
+	scorers := append([]string{matching.RecordedScorer}, matching.ScorerNames()...)
+	scored := make(map[string][]matching.ScoredOutcome)
+	for _, round := range rounds {
+		cohort, err := matching.LoadCohort(ctx, dbClient, round.ID)
+		if err != nil {
+			log.Fatalf("Failed to load users of round %d: %v", round.ID, err)
+		}
+		matches, err := dbClient.GetRoundMatches(ctx, round.ID)
+		if err != nil {
+			log.Fatalf("Failed to load matches of round %d: %v", round.ID, err)
+		}
+		feedback, err := dbClient.GetRoundMatchFeedback(ctx, round.ID)
+		if err != nil {
+			log.Fatalf("Failed to load feedback of round %d: %v", round.ID, err)
+		}
+
+		outcomes, skipped := matching.NewOutcomes(cohort, matches, feedback)
+		log.Printf("Round %d (%s): %d matches, %d feedback responses", round.ID, round.Name, len(matches), len(outcomes))
+		if skipped > 0 {
+			log.Printf("Skipped %d responses on matches with users who are no longer in round %d", skipped, round.ID)
+		}
+
+		settings := round.Settings
+		if *genreWeight >= 0 {
+			settings.GenreWeight = *genreWeight
+		}
+		scored[matching.RecordedScorer] = append(scored[matching.RecordedScorer], matching.RecordedOutcomes(outcomes)...)
+		for _, name := range matching.ScorerNames() {
+			settings.Scorer = name
+			opts, err := matching.OptionsForRound(cohort, settings)
+			if err != nil {
+				log.Fatalf("Invalid settings for round %d: %v", round.ID, err)
+			}
+			scored[name] = append(scored[name], matching.ScoreOutcomes(opts.Scorer, outcomes)...)
+		}
+	}
+
+	if len(scored[matching.RecordedScorer]) == 0 {
+		log.Fatalf("No feedback recorded for the replayed rounds")
+	}
+
+	curve := matching.ScoreCurve{Steepness: *steepness, Midpoint: *midpoint}
+	evals := make([]matching.Evaluation, len(scorers))
+	for i, name := range scorers {
+		evals[i] = matching.Evaluate(name, scored[name], curve)
+	}
+	printEvaluations(evals, curve)
+}
+
+// replayRounds returns the rounds with the given comma-separated IDs, or every
+// matched round if none are given
+func replayRounds(ctx context.Context, dbClient *db.DBClient, ids string) ([]db.Round, error) {
+	var rounds []db.Round
+	if ids == "" {
+		all, err := dbClient.ListRounds(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, round := range all {
+			if round.MatchedAt != nil {
+				rounds = append(rounds, round)
+			}
+		}
+		return rounds, nil
+	}
+
+	for _, field := range strings.Split(ids, ",") {
+		roundID, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return nil, fmt.Errorf("invalid round ID %q", field)
+		}
+		round, err := dbClient.GetRound(ctx, roundID)
+		if err != nil {
+			return nil, err
+		}
+		rounds = append(rounds, round)
+	}
+	return rounds, nil
+}
+
+func printEvaluations(evals []matching.Evaluation, curve matching.ScoreCurve) {
+	fmt.Printf("\n=== Scorer Evaluation (%d responses) ===\n", evals[0].Responses)
+	fmt.Printf("%-16s %18s %14s\n", "Scorer", "Rating (Spearman)", "Connected AUC")
+	for _, e := range evals {
+		fmt.Printf("%-16s %18s %14s\n", e.Scorer, metric(e.RatingCorrelation), metric(e.ConnectedAUC))
+	}
+
+	fmt.Printf("\nCalibration by match score (curve steepness %g, midpoint %g):\n", curve.Steepness, curve.Midpoint)
+	for _, e := range evals {
+		fmt.Printf("\n%s\n", e.Scorer)
+		for _, b := range e.Calibration {
+			if b.Responses == 0 {
+				continue
+			}
+			fmt.Printf("  %3d-%-3d %4d responses, %5.1f%% connected, mean rating %.2f\n",
+				b.MinScore, b.MaxScore, b.Responses, 100*float64(b.Connected)/float64(b.Responses), b.MeanRating)
+		}
+	}
+}
+
+// metric formats an evaluation metric, which is NaN when there was too little
+// feedback to compute it
+func metric(value float64) string {
+	if math.IsNaN(value) {
+		return "n/a"
+	}
+	return fmt.Sprintf("%.3f", value)
+}
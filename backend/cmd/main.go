@@ -17,11 +17,12 @@ import (
 )
 
 func main() {
-	server, err := api.NewDefaultServer()
+	server, err := api.NewServer()
 	if err != nil {
 		log.Fatal(err)
 	}
 	spotifyServer := api.NewSpotifyServer(server)
+	roundServer := api.NewRoundServer(server)
 
 	defer server.Close(context.Background())
 
@@ -34,6 +35,8 @@ func main() {
 
 	spotifyPath, spotifyHandler := spotifyv1connect.NewSpotifyServiceHandler(spotifyServer)
 	r.Mount(spotifyPath, spotifyHandler)
+	roundPath, roundHandler := spotifyv1connect.NewRoundServiceHandler(roundServer)
+	r.Mount(roundPath, roundHandler)
 
 	fmt.Println("Server starting on port 8080")
 	http.ListenAndServe(
@@ -0,0 +1,399 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sukhmai/spotify-match/pkg/db"
+	"github.com/sukhmai/spotify-match/pkg/matching"
+	"github.com/sukhmai/spotify-match/pkg/token"
+)
+
+func main() {
+	roundID := flag.Int("round", 0, "ID of the round to match (defaults to the most recently opened round)")
+	outDir := flag.String("out", "match_results", "Directory to write the match CSV to")
+	scorer := flag.String("scorer", "", "Similarity strategy to use instead of the round's setting")
+	mode := flag.String("mode", "", "Matching mode (pairs, groups, stable, discovery or bipartite) to use instead of the round's setting")
+	objective := flag.String("objective", "", "Pairs mode objective (total or bottleneck) to use instead of the round's setting")
+	candidates := flag.Int("candidates", -1, "Likely matches to score per user (0 scores every pair), instead of the round's setting")
+	minScore := flag.Int("min-score", -1, "Lowest match score (0-100) allowed, instead of the round's setting")
+	groupSize := flag.Int("group-size", 0, "Minimum group size in group mode, instead of the round's setting")
+	dryRun := flag.Bool("dry-run", false, "Print a report of the matching without saving it or writing a CSV")
+	allowRepeats := flag.Bool("allow-repeats", false, "Ignore who was matched with whom in earlier rounds")
+	closeRound := flag.Bool("close", false, "Close the round if it is still open, so that nobody can sign up after it is matched")
+	force := flag.Bool("force", false, "Replace the round's matches even if users were notified about them (notifying them again) or gave feedback on them (deleting it)")
+	genreWeight := flag.Float64("genre-weight", -1, "Share (0-1) of the similarity from genre overlap, instead of the round's setting")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	dbClient, err := db.NewClientFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer dbClient.Close()
+
+	var round db.Round
+	if *roundID != 0 {
+		round, err = dbClient.GetRound(ctx, *roundID)
+	} else {
+		round, err = dbClient.GetLatestRound(ctx)
+	}
+	if err != nil {
+		log.Fatalf("Failed to get round: %v", err)
+	}
+	log.Printf("Matching round %d (%s, %s)", round.ID, round.Name, round.Status)
+
+	cohort, err := matching.LoadCohort(ctx, dbClient, round.ID)
+	if err != nil {
+		log.Fatalf("Failed to load users: %v", err)
+	}
+	log.Printf("Loaded %d users with %d distinct artists", len(cohort.Members), len(cohort.Artists))
+	if len(cohort.Artistless) > 0 {
+		log.Printf("%d users have no artists and will be left unmatched", len(cohort.Artistless))
+	}
+
+	settings := round.Settings
+	if *scorer != "" {
+		settings.Scorer = *scorer
+	}
+	if *genreWeight >= 0 {
+		settings.GenreWeight = *genreWeight
+	}
+	if *mode != "" {
+		settings.Mode = *mode
+	}
+	if *objective != "" {
+		settings.Objective = *objective
+	}
+	if *groupSize != 0 {
+		settings.GroupSize = *groupSize
+	}
+	if *minScore >= 0 {
+		settings.MinScore = *minScore
+	}
+	if *candidates >= 0 {
+		settings.Candidates = *candidates
+	}
+	opts, err := matching.OptionsForRound(cohort, settings)
+	if err != nil {
+		log.Fatalf("Invalid matching options: %v", err)
+	}
+	log.Printf("Scoring similarity with %s", opts.Scorer.Name())
+
+	if !*allowRepeats {
+		pastPairs, err := dbClient.GetPastPairs(ctx, round.ID)
+		if err != nil {
+			log.Fatalf("Failed to load past matches: %v", err)
+		}
+		opts.History = matching.NewHistory(pastPairs)
+		log.Printf("Avoiding %d pairs matched in earlier rounds", len(pastPairs))
+	}
+
+	result := matching.Match(cohort, opts)
+
+	for _, pair := range result.Pairs {
+		printPair(cohort, pair)
+	}
+	for i, group := range result.Groups {
+		printGroup(cohort, i+1, group)
+	}
+	for _, m := range result.Unmatched {
+		fmt.Printf("\nUnmatched: %s %s (%s)\n", m.User.FirstName, m.User.LastName, m.User.Email)
+	}
+	if len(result.RelaxedUsers) > 0 {
+		fmt.Printf("\n%d users could only be matched with someone they were matched with before:\n", len(result.RelaxedUsers))
+		for _, m := range result.RelaxedUsers {
+			fmt.Printf("  - %s %s (%s)\n", m.User.FirstName, m.User.LastName, m.User.Email)
+		}
+	}
+
+	report := matching.NewReport(cohort, result, matching.DefaultReportSample)
+	if opts.Mode == matching.ModePairs || opts.Mode == matching.ModeBipartite {
+		report.Objectives = matching.CompareObjectives(cohort, opts)
+	}
+	printReport(cohort, report)
+	if *dryRun {
+		log.Printf("Dry run, nothing was saved")
+		return
+	}
+
+	saveOpts := db.SaveOptions{Force: *force, Close: *closeRound}
+	var csvPath string
+	if opts.Mode == matching.ModeGroups {
+		err = dbClient.SaveMatchGroups(ctx, round.ID, result.MatchGroups(), result.UnmatchedUserIDs(), saveOpts)
+		if errors.Is(err, db.ErrRoundOpen) {
+			log.Fatalf("Failed to save match groups: %v (close it first or pass -close)", err)
+		}
+		if errors.Is(err, db.ErrRoundNotified) || errors.Is(err, db.ErrRoundHasFeedback) {
+			log.Fatalf("Failed to save match groups: %v (pass -force to replace them anyway)", err)
+		}
+		if err != nil {
+			log.Fatalf("Failed to save match groups: %v", err)
+		}
+		log.Printf("Saved %d groups for round %d", len(result.Groups), round.ID)
+		csvPath, err = writeGroupsCSV(*outDir, cohort, result)
+	} else {
+		matches := result.Matches()
+		err = dbClient.SaveMatches(ctx, round.ID, matches, result.UnmatchedUserIDs(), saveOpts)
+		if errors.Is(err, db.ErrRoundOpen) {
+			log.Fatalf("Failed to save matches: %v (close it first or pass -close)", err)
+		}
+		if errors.Is(err, db.ErrRoundNotified) || errors.Is(err, db.ErrRoundHasFeedback) {
+			log.Fatalf("Failed to save matches: %v (pass -force to replace them anyway)", err)
+		}
+		if err != nil {
+			log.Fatalf("Failed to save matches: %v", err)
+		}
+		log.Printf("Saved %d matches for round %d", len(result.Pairs), round.ID)
+		var tokens [][2]string
+		tokens, err = feedbackTokens(result, matches)
+		if err != nil {
+			log.Fatalf("Failed to sign feedback tokens: %v", err)
+		}
+		csvPath, err = writeCSV(*outDir, cohort, result, tokens)
+	}
+	if len(result.Unmatched) > 0 {
+		log.Printf("Carried %d unmatched users over to the next round", len(result.Unmatched))
+	}
+	if err != nil {
+		log.Fatalf("Failed to write match results: %v", err)
+	}
+	fmt.Printf("\nMatch data exported to %s\n", csvPath)
+}
+
+func printPair(cohort *matching.Cohort, pair matching.Pair) {
+	a, b := pair.A.User, pair.B.User
+	fmt.Printf("\nMatch: %s %s (%s, %s) and %s %s (%s, %s)\n",
+		a.FirstName, a.LastName, a.Email, phoneOrDefault(a.PhoneNumber),
+		b.FirstName, b.LastName, b.Email, phoneOrDefault(b.PhoneNumber))
+	fmt.Printf("User IDs: %d and %d\n", a.ID, b.ID)
+	fmt.Printf("Similarity: %.4f (Match Score: %d/100)\n", pair.Similarity, pair.MatchScore)
+	if pair.Repeat {
+		fmt.Println("Note: these users were matched in an earlier round")
+	}
+	if pair.Discovery {
+		fmt.Printf("Discovery match (Discovery Score: %.4f)\n", cohort.DiscoveryScore(pair.A, pair.B))
+	}
+	fmt.Printf("Common Artists (%d):\n", len(pair.CommonArtists))
+	for _, artistID := range pair.CommonArtists {
+		fmt.Printf("  - %s (ID: %d)\n", cohort.ArtistName(artistID), artistID)
+	}
+	if len(pair.CommonGenres) > 0 {
+		fmt.Printf("Common Genres: %s\n", strings.Join(pair.CommonGenres, ", "))
+	}
+}
+
+func printGroup(cohort *matching.Cohort, number int, group matching.Group) {
+	fmt.Printf("\nGroup %d:\n", number)
+	for _, m := range group.Members {
+		u := m.User
+		fmt.Printf("  - %s %s (%s, %s, ID: %d)\n", u.FirstName, u.LastName, u.Email, phoneOrDefault(u.PhoneNumber), u.ID)
+	}
+	fmt.Printf("Mean Similarity: %.4f (Match Score: %d/100)\n", group.Similarity, group.MatchScore)
+	fmt.Printf("Artists Everyone Shares (%d):\n", len(group.CommonArtists))
+	for _, artistID := range group.CommonArtists {
+		fmt.Printf("  - %s (ID: %d)\n", cohort.ArtistName(artistID), artistID)
+	}
+	if len(group.CommonGenres) > 0 {
+		fmt.Printf("Common Genres: %s\n", strings.Join(group.CommonGenres, ", "))
+	}
+}
+
+func printReport(cohort *matching.Cohort, report *matching.Report) {
+	fmt.Printf("\n=== Summary ===\n")
+	fmt.Printf("Users: %d, matches: %d, unmatched: %d\n", report.Users, report.Matches, report.Unmatched)
+	fmt.Printf("Mean match score: %.1f\n", report.MeanScore)
+	fmt.Printf("Matches with no shared artists: %d\n", report.NoSharedArtists)
+	fmt.Printf("Scored %d pairs in %s, allocating %.1f MB\n",
+		report.Stats.Edges, report.Stats.Elapsed.Round(time.Millisecond), float64(report.Stats.AllocatedBytes)/(1<<20))
+	if report.Relaxed > 0 {
+		fmt.Printf("Users matched with a past partner: %d\n", report.Relaxed)
+	}
+	if report.Discovery > 0 {
+		fmt.Printf("Discovery matches: %d\n", report.Discovery)
+	}
+	for _, side := range report.Sides {
+		fmt.Printf("Side %s: %d users, %d matched, %d left over\n",
+			side.Side, side.Members, side.Matched, side.Members-side.Matched)
+	}
+	if report.Unsided > 0 {
+		fmt.Printf("Users without a side: %d\n", report.Unsided)
+	}
+	if report.Unstable {
+		fmt.Println("No stable matching exists, matched by total similarity instead")
+	}
+	if report.Fairness.WorstPartnerRank > 0 {
+		fmt.Printf("Mean partner rank: %.1f (worst %d), blocking pairs: %d\n",
+			report.Fairness.MeanPartnerRank, report.Fairness.WorstPartnerRank, report.Fairness.BlockingPairs)
+	}
+
+	if len(report.Objectives) > 0 {
+		fmt.Println("Match scores by objective:")
+		for _, o := range report.Objectives {
+			fmt.Printf("  %-10s matches %4d, min %3d, median %5.1f, mean %5.1f\n",
+				o.Objective, o.Matches, o.MinScore, o.MedianScore, o.MeanScore)
+		}
+	}
+
+	fmt.Println("Match score distribution:")
+	for i, count := range report.ScoreHistogram {
+		high := i*10 + 9
+		if i == len(report.ScoreHistogram)-1 {
+			high = 100
+		}
+		fmt.Printf("  %3d-%-3d %4d %s\n", i*10, high, count, strings.Repeat("#", count))
+	}
+
+	fmt.Println("Top matches:")
+	for _, e := range report.Top {
+		printReportEntry(cohort, e)
+	}
+	fmt.Println("Bottom matches:")
+	for _, e := range report.Bottom {
+		printReportEntry(cohort, e)
+	}
+}
+
+func printReportEntry(cohort *matching.Cohort, e matching.ReportEntry) {
+	names := make([]string, len(e.Members))
+	for i, m := range e.Members {
+		names[i] = m.User.FirstName + " " + m.User.LastName
+	}
+	artists := make([]string, 0, 3)
+	for _, artistID := range e.CommonArtists[:min(len(e.CommonArtists), 3)] {
+		artists = append(artists, cohort.ArtistName(artistID))
+	}
+	fmt.Printf("  %3d  %.4f  %s  [%s]\n", e.MatchScore, e.Similarity, strings.Join(names, " & "), strings.Join(artists, ", "))
+}
+
+func phoneOrDefault(phone string) string {
+	if phone == "" {
+		return "No phone"
+	}
+	return phone
+}
+
+// feedbackTokens signs a feedback token for both users of each saved match,
+// in the order of the result's pairs. Without TOKEN_SECRET no tokens are
+// signed and the emails go out without a feedback link.
+func feedbackTokens(result *matching.Result, matches []db.Match) ([][2]string, error) {
+	tokens := make([][2]string, len(result.Pairs))
+	secret := os.Getenv("TOKEN_SECRET")
+	if secret == "" {
+		log.Printf("Warning: TOKEN_SECRET is not set, the match emails will not link to the feedback form")
+		return tokens, nil
+	}
+
+	signer := token.NewSigner([]byte(secret))
+	for i, pair := range result.Pairs {
+		for j, m := range []*matching.Member{pair.A, pair.B} {
+			t, err := signer.SignFeedback(token.MatchToken{MatchID: matches[i].ID, UserID: m.User.ID})
+			if err != nil {
+				return nil, err
+			}
+			tokens[i][j] = t
+		}
+	}
+	return tokens, nil
+}
+
+// writeCSV writes the matches in the same format as matching.py so that
+// send_match_emails.py can consume them, along with each user's feedback token
+func writeCSV(outDir string, cohort *matching.Cohort, result *matching.Result, tokens [][2]string) (string, error) {
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return "", err
+	}
+
+	csvPath := filepath.Join(outDir, fmt.Sprintf("matches_%s.csv", time.Now().Format("20060102_150405")))
+	f, err := os.Create(csvPath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	err = w.Write([]string{
+		"user1_id", "user1_first_name", "user1_last_name", "user1_email", "user1_phone",
+		"user2_id", "user2_first_name", "user2_last_name", "user2_email", "user2_phone",
+		"similarity_score", "match_score", "common_artists",
+		"user1_feedback_token", "user2_feedback_token",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for i, pair := range result.Pairs {
+		a, b := pair.A.User, pair.B.User
+		names := make([]string, len(pair.CommonArtists))
+		for j, artistID := range pair.CommonArtists {
+			names[j] = cohort.ArtistName(artistID)
+		}
+		err := w.Write([]string{
+			strconv.Itoa(a.ID), a.FirstName, a.LastName, a.Email, a.PhoneNumber,
+			strconv.Itoa(b.ID), b.FirstName, b.LastName, b.Email, b.PhoneNumber,
+			strconv.FormatFloat(pair.Similarity, 'f', -1, 64), strconv.Itoa(pair.MatchScore),
+			strings.Join(names, "|"), tokens[i][0], tokens[i][1],
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	w.Flush()
+	return csvPath, w.Error()
+}
+
+// writeGroupsCSV writes one row per group member, with the group's score and
+// shared artists repeated on each row
+func writeGroupsCSV(outDir string, cohort *matching.Cohort, result *matching.Result) (string, error) {
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return "", err
+	}
+
+	csvPath := filepath.Join(outDir, fmt.Sprintf("groups_%s.csv", time.Now().Format("20060102_150405")))
+	f, err := os.Create(csvPath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	err = w.Write([]string{
+		"group", "user_id", "first_name", "last_name", "email", "phone",
+		"similarity_score", "match_score", "common_artists",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for i, group := range result.Groups {
+		names := make([]string, len(group.CommonArtists))
+		for j, artistID := range group.CommonArtists {
+			names[j] = cohort.ArtistName(artistID)
+		}
+		for _, m := range group.Members {
+			u := m.User
+			err := w.Write([]string{
+				strconv.Itoa(i + 1), strconv.Itoa(u.ID), u.FirstName, u.LastName, u.Email, u.PhoneNumber,
+				strconv.FormatFloat(group.Similarity, 'f', -1, 64), strconv.Itoa(group.MatchScore),
+				strings.Join(names, "|"),
+			})
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+
+	w.Flush()
+	return csvPath, w.Error()
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/sukhmai/spotify-match/pkg/db"
+	"github.com/sukhmai/spotify-match/pkg/notify"
+	"github.com/sukhmai/spotify-match/pkg/token"
+)
+
+func main() {
+	roundID := flag.Int("round", 0, "ID of the round whose matches to send (defaults to the most recently matched round)")
+	mailer := flag.String("mailer", notify.MailerMaildir, "Mailer to send with (smtp, mailgun or maildir)")
+	smsProvider := flag.String("sms", notify.SMSFake, "SMS provider to send texts with (twilio or fake)")
+	smsFrom := flag.String("sms-from", "", "Phone number to send texts from; users who chose texts are only texted when set")
+	from := flag.String("from", "", "Sender address, e.g. \"Music Match <matches@yourdomain.com>\"")
+	subject := flag.String("subject", "Your Musical Match!", "Email subject")
+	templatePath := flag.String("template", "", "Path to a Go template for the email body (defaults to the built-in template)")
+	responseURL := flag.String("response-url", "", "URL of the page where users accept or decline their match, e.g. https://yourdomain.com/match")
+	feedbackURL := flag.String("feedback-url", "", "URL of the match feedback page for templates that link to it, e.g. https://yourdomain.com/feedback")
+	limit := flag.Int("limit", 0, "Limit the number of emails to send")
+	dryRun := flag.Bool("dry-run", false, "Print the emails instead of sending them")
+	enqueueOnly := flag.Bool("enqueue-only", false, "Add the emails to the outbox without sending them")
+	requeueFailed := flag.Bool("requeue-failed", false, "Retry the notifications that failed for good in earlier runs")
+	maxAttempts := flag.Int("max-attempts", notify.DefaultMaxAttempts, "Delivery attempts per email before giving up")
+	watch := flag.Duration("watch", 0, "Keep running and deliver due notifications at this interval, e.g. 1m")
+	flag.Parse()
+
+	if *from == "" {
+		log.Fatalf("-from is required")
+	}
+	if *responseURL == "" && *templatePath == "" {
+		log.Fatalf("-response-url is required, the default template asks users to accept or decline their match there")
+	}
+
+	ctx := context.Background()
+
+	dbClient, err := db.NewClientFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer dbClient.Close()
+
+	var round db.Round
+	if *roundID != 0 {
+		round, err = dbClient.GetRound(ctx, *roundID)
+	} else {
+		round, err = dbClient.GetLatestMatchedRound(ctx)
+	}
+	if err != nil {
+		log.Fatalf("Failed to get round: %v", err)
+	}
+	if round.MatchedAt == nil {
+		log.Fatalf("Round %d has not been matched yet", round.ID)
+	}
+
+	var links *notify.Links
+	if *responseURL != "" || *feedbackURL != "" {
+		secret := os.Getenv("TOKEN_SECRET")
+		if secret == "" {
+			log.Fatalf("TOKEN_SECRET environment variable not set, it is needed to sign response and feedback links")
+		}
+		links = &notify.Links{
+			Signer:      token.NewSigner([]byte(secret)),
+			ResponseURL: *responseURL,
+			FeedbackURL: *feedbackURL,
+		}
+	}
+
+	emails, err := notify.LoadMatchEmails(ctx, dbClient, round.ID, links)
+	if err != nil {
+		log.Fatalf("Failed to load matches: %v", err)
+	}
+	log.Printf("Loaded %d emails for round %d (%s)", len(emails), round.ID, round.Name)
+	if *limit > 0 && *limit < len(emails) {
+		emails = emails[:*limit]
+		log.Printf("Limiting to %d emails", *limit)
+	}
+
+	tmpl := notify.DefaultMatchTemplate()
+	if *templatePath != "" {
+		tmpl, err = notify.LoadMatchTemplate(*templatePath)
+		if err != nil {
+			log.Fatalf("Failed to load template: %v", err)
+		}
+	}
+
+	notifiers := []*notify.Notifier{
+		{Template: tmpl, Name: notify.TemplateMatch, From: *from, Subject: *subject},
+	}
+	if *smsFrom != "" {
+		if *responseURL == "" {
+			log.Fatalf("-response-url is required with -sms-from, texts link to the match page")
+		}
+		notifiers = append(notifiers, &notify.Notifier{
+			Template: notify.DefaultMatchSMSTemplate(),
+			Name:     notify.TemplateMatch,
+			Channel:  notify.ChannelSMS,
+			From:     *smsFrom,
+		})
+	}
+
+	// Each notifier only renders messages for the users who chose its channel
+	var notifications []db.Notification
+	for _, notifier := range notifiers {
+		rendered, err := notifier.Notifications(emails)
+		if err != nil {
+			log.Fatalf("Failed to render messages: %v", err)
+		}
+		notifications = append(notifications, rendered...)
+	}
+	if *dryRun {
+		for _, n := range notifications {
+			fmt.Printf("\n[%s] To: %s\nSubject: %s\n\n%s\n", n.Channel, n.Recipient, n.Subject, n.Body)
+		}
+		log.Printf("Dry run, nothing was sent")
+		return
+	}
+
+	added, err := dbClient.EnqueueNotifications(ctx, notifications)
+	if err != nil {
+		log.Fatalf("Failed to enqueue messages: %v", err)
+	}
+	log.Printf("Added %d messages to the outbox, %d were already in it", added, len(notifications)-added)
+	if *requeueFailed {
+		requeued, err := dbClient.RequeueFailedNotifications(ctx)
+		if err != nil {
+			log.Fatalf("Failed to requeue notifications: %v", err)
+		}
+		log.Printf("Requeued %d failed notifications", requeued)
+	}
+	if *enqueueOnly {
+		return
+	}
+
+	m, err := notify.NewMailerFromEnv(*mailer)
+	if err != nil {
+		log.Fatalf("Failed to create mailer: %v", err)
+	}
+	sms, err := notify.NewSMSSenderFromEnv(*smsProvider)
+	if err != nil {
+		log.Fatalf("Failed to create SMS provider: %v", err)
+	}
+	worker := notify.Worker{DB: dbClient, Mailer: m, SMS: sms, MaxAttempts: *maxAttempts}
+
+	if *watch > 0 {
+		ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		log.Printf("Delivering notifications with %s and %s every %s", *mailer, *smsProvider, *watch)
+		if err := worker.Run(ctx, *watch); err != nil {
+			log.Fatalf("Failed to deliver notifications: %v", err)
+		}
+		return
+	}
+
+	sent, failed, err := worker.Drain(ctx)
+	log.Printf("Sent %d notifications with %s and %s, %d failed for good", sent, *mailer, *smsProvider, failed)
+	if err != nil {
+		log.Fatalf("Failed to deliver notifications: %v", err)
+	}
+	counts, err := dbClient.GetOutboxCounts(ctx)
+	if err != nil {
+		log.Fatalf("Failed to count notifications: %v", err)
+	}
+	if pending := counts[db.NotificationStatusPending]; pending > 0 {
+		log.Printf("%d notifications are waiting to be retried, run again later or use -watch", pending)
+	}
+	if counts[db.NotificationStatusFailed] > 0 {
+		log.Printf("%d notifications have failed for good, fix the cause and run with -requeue-failed", counts[db.NotificationStatusFailed])
+	}
+}
@@ -23,6 +23,8 @@ const _ = connect.IsAtLeastVersion1_13_0
 const (
 	// SpotifyServiceName is the fully-qualified name of the SpotifyService service.
 	SpotifyServiceName = "spotify.v1.SpotifyService"
+	// RoundServiceName is the fully-qualified name of the RoundService service.
+	RoundServiceName = "spotify.v1.RoundService"
 )
 
 // These constants are the fully-qualified names of the RPCs defined in this package. They're
@@ -51,6 +53,59 @@ const (
 	// SpotifyServiceSaveUserSelectedArtistsProcedure is the fully-qualified name of the
 	// SpotifyService's SaveUserSelectedArtists RPC.
 	SpotifyServiceSaveUserSelectedArtistsProcedure = "/spotify.v1.SpotifyService/SaveUserSelectedArtists"
+	// SpotifyServiceGetMyMatchProcedure is the fully-qualified name of the SpotifyService's GetMyMatch
+	// RPC.
+	SpotifyServiceGetMyMatchProcedure = "/spotify.v1.SpotifyService/GetMyMatch"
+	// SpotifyServiceExplainMatchProcedure is the fully-qualified name of the SpotifyService's
+	// ExplainMatch RPC.
+	SpotifyServiceExplainMatchProcedure = "/spotify.v1.SpotifyService/ExplainMatch"
+	// SpotifyServiceSubmitMatchFeedbackProcedure is the fully-qualified name of the SpotifyService's
+	// SubmitMatchFeedback RPC.
+	SpotifyServiceSubmitMatchFeedbackProcedure = "/spotify.v1.SpotifyService/SubmitMatchFeedback"
+	// SpotifyServiceGetMatchContactProcedure is the fully-qualified name of the SpotifyService's
+	// GetMatchContact RPC.
+	SpotifyServiceGetMatchContactProcedure = "/spotify.v1.SpotifyService/GetMatchContact"
+	// SpotifyServiceRespondToMatchProcedure is the fully-qualified name of the SpotifyService's
+	// RespondToMatch RPC.
+	SpotifyServiceRespondToMatchProcedure = "/spotify.v1.SpotifyService/RespondToMatch"
+	// SpotifyServiceVerifyEmailProcedure is the fully-qualified name of the SpotifyService's
+	// VerifyEmail RPC.
+	SpotifyServiceVerifyEmailProcedure = "/spotify.v1.SpotifyService/VerifyEmail"
+	// SpotifyServiceResendVerificationProcedure is the fully-qualified name of the SpotifyService's
+	// ResendVerification RPC.
+	SpotifyServiceResendVerificationProcedure = "/spotify.v1.SpotifyService/ResendVerification"
+	// SpotifyServiceGetMyProfileProcedure is the fully-qualified name of the SpotifyService's
+	// GetMyProfile RPC.
+	SpotifyServiceGetMyProfileProcedure = "/spotify.v1.SpotifyService/GetMyProfile"
+	// SpotifyServiceUpdateProfileProcedure is the fully-qualified name of the SpotifyService's
+	// UpdateProfile RPC.
+	SpotifyServiceUpdateProfileProcedure = "/spotify.v1.SpotifyService/UpdateProfile"
+	// SpotifyServiceUpdateMyArtistsProcedure is the fully-qualified name of the SpotifyService's
+	// UpdateMyArtists RPC.
+	SpotifyServiceUpdateMyArtistsProcedure = "/spotify.v1.SpotifyService/UpdateMyArtists"
+	// RoundServiceCreateRoundProcedure is the fully-qualified name of the RoundService's CreateRound
+	// RPC.
+	RoundServiceCreateRoundProcedure = "/spotify.v1.RoundService/CreateRound"
+	// RoundServiceOpenRoundProcedure is the fully-qualified name of the RoundService's OpenRound RPC.
+	RoundServiceOpenRoundProcedure = "/spotify.v1.RoundService/OpenRound"
+	// RoundServiceCloseRoundProcedure is the fully-qualified name of the RoundService's CloseRound RPC.
+	RoundServiceCloseRoundProcedure = "/spotify.v1.RoundService/CloseRound"
+	// RoundServiceGetRoundProcedure is the fully-qualified name of the RoundService's GetRound RPC.
+	RoundServiceGetRoundProcedure = "/spotify.v1.RoundService/GetRound"
+	// RoundServiceListRoundsProcedure is the fully-qualified name of the RoundService's ListRounds RPC.
+	RoundServiceListRoundsProcedure = "/spotify.v1.RoundService/ListRounds"
+	// RoundServiceUpdateRoundSettingsProcedure is the fully-qualified name of the RoundService's
+	// UpdateRoundSettings RPC.
+	RoundServiceUpdateRoundSettingsProcedure = "/spotify.v1.RoundService/UpdateRoundSettings"
+	// RoundServiceSimulateMatchingProcedure is the fully-qualified name of the RoundService's
+	// SimulateMatching RPC.
+	RoundServiceSimulateMatchingProcedure = "/spotify.v1.RoundService/SimulateMatching"
+	// RoundServiceGetRoundFeedbackProcedure is the fully-qualified name of the RoundService's
+	// GetRoundFeedback RPC.
+	RoundServiceGetRoundFeedbackProcedure = "/spotify.v1.RoundService/GetRoundFeedback"
+	// RoundServiceRevokeMatchLinksProcedure is the fully-qualified name of the RoundService's
+	// RevokeMatchLinks RPC.
+	RoundServiceRevokeMatchLinksProcedure = "/spotify.v1.RoundService/RevokeMatchLinks"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -62,6 +117,26 @@ var (
 	spotifyServiceGetUserCountMethodDescriptor            = spotifyServiceServiceDescriptor.Methods().ByName("GetUserCount")
 	spotifyServiceSearchArtistsMethodDescriptor           = spotifyServiceServiceDescriptor.Methods().ByName("SearchArtists")
 	spotifyServiceSaveUserSelectedArtistsMethodDescriptor = spotifyServiceServiceDescriptor.Methods().ByName("SaveUserSelectedArtists")
+	spotifyServiceGetMyMatchMethodDescriptor              = spotifyServiceServiceDescriptor.Methods().ByName("GetMyMatch")
+	spotifyServiceExplainMatchMethodDescriptor            = spotifyServiceServiceDescriptor.Methods().ByName("ExplainMatch")
+	spotifyServiceSubmitMatchFeedbackMethodDescriptor     = spotifyServiceServiceDescriptor.Methods().ByName("SubmitMatchFeedback")
+	spotifyServiceGetMatchContactMethodDescriptor         = spotifyServiceServiceDescriptor.Methods().ByName("GetMatchContact")
+	spotifyServiceRespondToMatchMethodDescriptor          = spotifyServiceServiceDescriptor.Methods().ByName("RespondToMatch")
+	spotifyServiceVerifyEmailMethodDescriptor             = spotifyServiceServiceDescriptor.Methods().ByName("VerifyEmail")
+	spotifyServiceResendVerificationMethodDescriptor      = spotifyServiceServiceDescriptor.Methods().ByName("ResendVerification")
+	spotifyServiceGetMyProfileMethodDescriptor            = spotifyServiceServiceDescriptor.Methods().ByName("GetMyProfile")
+	spotifyServiceUpdateProfileMethodDescriptor           = spotifyServiceServiceDescriptor.Methods().ByName("UpdateProfile")
+	spotifyServiceUpdateMyArtistsMethodDescriptor         = spotifyServiceServiceDescriptor.Methods().ByName("UpdateMyArtists")
+	roundServiceServiceDescriptor                         = v1.File_spotify_v1_spotify_proto.Services().ByName("RoundService")
+	roundServiceCreateRoundMethodDescriptor               = roundServiceServiceDescriptor.Methods().ByName("CreateRound")
+	roundServiceOpenRoundMethodDescriptor                 = roundServiceServiceDescriptor.Methods().ByName("OpenRound")
+	roundServiceCloseRoundMethodDescriptor                = roundServiceServiceDescriptor.Methods().ByName("CloseRound")
+	roundServiceGetRoundMethodDescriptor                  = roundServiceServiceDescriptor.Methods().ByName("GetRound")
+	roundServiceListRoundsMethodDescriptor                = roundServiceServiceDescriptor.Methods().ByName("ListRounds")
+	roundServiceUpdateRoundSettingsMethodDescriptor       = roundServiceServiceDescriptor.Methods().ByName("UpdateRoundSettings")
+	roundServiceSimulateMatchingMethodDescriptor          = roundServiceServiceDescriptor.Methods().ByName("SimulateMatching")
+	roundServiceGetRoundFeedbackMethodDescriptor          = roundServiceServiceDescriptor.Methods().ByName("GetRoundFeedback")
+	roundServiceRevokeMatchLinksMethodDescriptor          = roundServiceServiceDescriptor.Methods().ByName("RevokeMatchLinks")
 )
 
 // SpotifyServiceClient is a client for the spotify.v1.SpotifyService service.
@@ -71,12 +146,32 @@ type SpotifyServiceClient interface {
 	GetAuthURL(context.Context, *connect.Request[v1.GetAuthURLRequest]) (*connect.Response[v1.GetAuthURLResponse], error)
 	// ExchangeToken exchanges the authorization code for access and refresh tokens.
 	ExchangeToken(context.Context, *connect.Request[v1.ExchangeTokenRequest]) (*connect.Response[v1.ExchangeTokenResponse], error)
-	// GetUserCount retrieves the total number of users in the system.
+	// GetUserCount retrieves the number of users in the currently open round.
 	GetUserCount(context.Context, *connect.Request[v1.GetUserCountRequest]) (*connect.Response[v1.GetUserCountResponse], error)
 	// SearchArtists searches the database for artists matching the query.
 	SearchArtists(context.Context, *connect.Request[v1.SearchArtistsRequest]) (*connect.Response[v1.SearchArtistsResponse], error)
 	// SaveUserSelectedArtists saves manually selected artists for a user.
 	SaveUserSelectedArtists(context.Context, *connect.Request[v1.SaveUserSelectedArtistsRequest]) (*connect.Response[v1.SaveUserSelectedArtistsResponse], error)
+	// GetMyMatch retrieves the match of the user identified by the user token.
+	GetMyMatch(context.Context, *connect.Request[v1.GetMyMatchRequest]) (*connect.Response[v1.GetMyMatchResponse], error)
+	// ExplainMatch breaks down why the user was matched with their partner.
+	ExplainMatch(context.Context, *connect.Request[v1.ExplainMatchRequest]) (*connect.Response[v1.ExplainMatchResponse], error)
+	// SubmitMatchFeedback records how a match went for the user the feedback token was issued to.
+	SubmitMatchFeedback(context.Context, *connect.Request[v1.SubmitMatchFeedbackRequest]) (*connect.Response[v1.SubmitMatchFeedbackResponse], error)
+	// GetMatchContact retrieves the match a response token was issued for, with the partner's contact details once both users accepted.
+	GetMatchContact(context.Context, *connect.Request[v1.GetMatchContactRequest]) (*connect.Response[v1.GetMatchContactResponse], error)
+	// RespondToMatch accepts or declines sharing contact details with the match a response token was issued for.
+	RespondToMatch(context.Context, *connect.Request[v1.RespondToMatchRequest]) (*connect.Response[v1.RespondToMatchResponse], error)
+	// VerifyEmail confirms a manual signup's email address, after which the user counts toward the round.
+	VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error)
+	// ResendVerification sends the verification email of a pending signup again.
+	ResendVerification(context.Context, *connect.Request[v1.ResendVerificationRequest]) (*connect.Response[v1.ResendVerificationResponse], error)
+	// GetMyProfile returns the profile of the user identified by the user token.
+	GetMyProfile(context.Context, *connect.Request[v1.GetMyProfileRequest]) (*connect.Response[v1.GetMyProfileResponse], error)
+	// UpdateProfile changes the user's name, phone number and notification channels while their round is open.
+	UpdateProfile(context.Context, *connect.Request[v1.UpdateProfileRequest]) (*connect.Response[v1.UpdateProfileResponse], error)
+	// UpdateMyArtists replaces the user's artists for their round while it is open.
+	UpdateMyArtists(context.Context, *connect.Request[v1.UpdateMyArtistsRequest]) (*connect.Response[v1.UpdateMyArtistsResponse], error)
 }
 
 // NewSpotifyServiceClient constructs a client for the spotify.v1.SpotifyService service. By
@@ -125,6 +220,66 @@ func NewSpotifyServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(spotifyServiceSaveUserSelectedArtistsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getMyMatch: connect.NewClient[v1.GetMyMatchRequest, v1.GetMyMatchResponse](
+			httpClient,
+			baseURL+SpotifyServiceGetMyMatchProcedure,
+			connect.WithSchema(spotifyServiceGetMyMatchMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		explainMatch: connect.NewClient[v1.ExplainMatchRequest, v1.ExplainMatchResponse](
+			httpClient,
+			baseURL+SpotifyServiceExplainMatchProcedure,
+			connect.WithSchema(spotifyServiceExplainMatchMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		submitMatchFeedback: connect.NewClient[v1.SubmitMatchFeedbackRequest, v1.SubmitMatchFeedbackResponse](
+			httpClient,
+			baseURL+SpotifyServiceSubmitMatchFeedbackProcedure,
+			connect.WithSchema(spotifyServiceSubmitMatchFeedbackMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getMatchContact: connect.NewClient[v1.GetMatchContactRequest, v1.GetMatchContactResponse](
+			httpClient,
+			baseURL+SpotifyServiceGetMatchContactProcedure,
+			connect.WithSchema(spotifyServiceGetMatchContactMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		respondToMatch: connect.NewClient[v1.RespondToMatchRequest, v1.RespondToMatchResponse](
+			httpClient,
+			baseURL+SpotifyServiceRespondToMatchProcedure,
+			connect.WithSchema(spotifyServiceRespondToMatchMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		verifyEmail: connect.NewClient[v1.VerifyEmailRequest, v1.VerifyEmailResponse](
+			httpClient,
+			baseURL+SpotifyServiceVerifyEmailProcedure,
+			connect.WithSchema(spotifyServiceVerifyEmailMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		resendVerification: connect.NewClient[v1.ResendVerificationRequest, v1.ResendVerificationResponse](
+			httpClient,
+			baseURL+SpotifyServiceResendVerificationProcedure,
+			connect.WithSchema(spotifyServiceResendVerificationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getMyProfile: connect.NewClient[v1.GetMyProfileRequest, v1.GetMyProfileResponse](
+			httpClient,
+			baseURL+SpotifyServiceGetMyProfileProcedure,
+			connect.WithSchema(spotifyServiceGetMyProfileMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateProfile: connect.NewClient[v1.UpdateProfileRequest, v1.UpdateProfileResponse](
+			httpClient,
+			baseURL+SpotifyServiceUpdateProfileProcedure,
+			connect.WithSchema(spotifyServiceUpdateProfileMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateMyArtists: connect.NewClient[v1.UpdateMyArtistsRequest, v1.UpdateMyArtistsResponse](
+			httpClient,
+			baseURL+SpotifyServiceUpdateMyArtistsProcedure,
+			connect.WithSchema(spotifyServiceUpdateMyArtistsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -136,6 +291,16 @@ type spotifyServiceClient struct {
 	getUserCount            *connect.Client[v1.GetUserCountRequest, v1.GetUserCountResponse]
 	searchArtists           *connect.Client[v1.SearchArtistsRequest, v1.SearchArtistsResponse]
 	saveUserSelectedArtists *connect.Client[v1.SaveUserSelectedArtistsRequest, v1.SaveUserSelectedArtistsResponse]
+	getMyMatch              *connect.Client[v1.GetMyMatchRequest, v1.GetMyMatchResponse]
+	explainMatch            *connect.Client[v1.ExplainMatchRequest, v1.ExplainMatchResponse]
+	submitMatchFeedback     *connect.Client[v1.SubmitMatchFeedbackRequest, v1.SubmitMatchFeedbackResponse]
+	getMatchContact         *connect.Client[v1.GetMatchContactRequest, v1.GetMatchContactResponse]
+	respondToMatch          *connect.Client[v1.RespondToMatchRequest, v1.RespondToMatchResponse]
+	verifyEmail             *connect.Client[v1.VerifyEmailRequest, v1.VerifyEmailResponse]
+	resendVerification      *connect.Client[v1.ResendVerificationRequest, v1.ResendVerificationResponse]
+	getMyProfile            *connect.Client[v1.GetMyProfileRequest, v1.GetMyProfileResponse]
+	updateProfile           *connect.Client[v1.UpdateProfileRequest, v1.UpdateProfileResponse]
+	updateMyArtists         *connect.Client[v1.UpdateMyArtistsRequest, v1.UpdateMyArtistsResponse]
 }
 
 // SaveTopArtists calls spotify.v1.SpotifyService.SaveTopArtists.
@@ -168,6 +333,56 @@ func (c *spotifyServiceClient) SaveUserSelectedArtists(ctx context.Context, req
 	return c.saveUserSelectedArtists.CallUnary(ctx, req)
 }
 
+// GetMyMatch calls spotify.v1.SpotifyService.GetMyMatch.
+func (c *spotifyServiceClient) GetMyMatch(ctx context.Context, req *connect.Request[v1.GetMyMatchRequest]) (*connect.Response[v1.GetMyMatchResponse], error) {
+	return c.getMyMatch.CallUnary(ctx, req)
+}
+
+// ExplainMatch calls spotify.v1.SpotifyService.ExplainMatch.
+func (c *spotifyServiceClient) ExplainMatch(ctx context.Context, req *connect.Request[v1.ExplainMatchRequest]) (*connect.Response[v1.ExplainMatchResponse], error) {
+	return c.explainMatch.CallUnary(ctx, req)
+}
+
+// SubmitMatchFeedback calls spotify.v1.SpotifyService.SubmitMatchFeedback.
+func (c *spotifyServiceClient) SubmitMatchFeedback(ctx context.Context, req *connect.Request[v1.SubmitMatchFeedbackRequest]) (*connect.Response[v1.SubmitMatchFeedbackResponse], error) {
+	return c.submitMatchFeedback.CallUnary(ctx, req)
+}
+
+// GetMatchContact calls spotify.v1.SpotifyService.GetMatchContact.
+func (c *spotifyServiceClient) GetMatchContact(ctx context.Context, req *connect.Request[v1.GetMatchContactRequest]) (*connect.Response[v1.GetMatchContactResponse], error) {
+	return c.getMatchContact.CallUnary(ctx, req)
+}
+
+// RespondToMatch calls spotify.v1.SpotifyService.RespondToMatch.
+func (c *spotifyServiceClient) RespondToMatch(ctx context.Context, req *connect.Request[v1.RespondToMatchRequest]) (*connect.Response[v1.RespondToMatchResponse], error) {
+	return c.respondToMatch.CallUnary(ctx, req)
+}
+
+// VerifyEmail calls spotify.v1.SpotifyService.VerifyEmail.
+func (c *spotifyServiceClient) VerifyEmail(ctx context.Context, req *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error) {
+	return c.verifyEmail.CallUnary(ctx, req)
+}
+
+// ResendVerification calls spotify.v1.SpotifyService.ResendVerification.
+func (c *spotifyServiceClient) ResendVerification(ctx context.Context, req *connect.Request[v1.ResendVerificationRequest]) (*connect.Response[v1.ResendVerificationResponse], error) {
+	return c.resendVerification.CallUnary(ctx, req)
+}
+
+// GetMyProfile calls spotify.v1.SpotifyService.GetMyProfile.
+func (c *spotifyServiceClient) GetMyProfile(ctx context.Context, req *connect.Request[v1.GetMyProfileRequest]) (*connect.Response[v1.GetMyProfileResponse], error) {
+	return c.getMyProfile.CallUnary(ctx, req)
+}
+
+// UpdateProfile calls spotify.v1.SpotifyService.UpdateProfile.
+func (c *spotifyServiceClient) UpdateProfile(ctx context.Context, req *connect.Request[v1.UpdateProfileRequest]) (*connect.Response[v1.UpdateProfileResponse], error) {
+	return c.updateProfile.CallUnary(ctx, req)
+}
+
+// UpdateMyArtists calls spotify.v1.SpotifyService.UpdateMyArtists.
+func (c *spotifyServiceClient) UpdateMyArtists(ctx context.Context, req *connect.Request[v1.UpdateMyArtistsRequest]) (*connect.Response[v1.UpdateMyArtistsResponse], error) {
+	return c.updateMyArtists.CallUnary(ctx, req)
+}
+
 // SpotifyServiceHandler is an implementation of the spotify.v1.SpotifyService service.
 type SpotifyServiceHandler interface {
 	SaveTopArtists(context.Context, *connect.Request[v1.SaveTopArtistsRequest]) (*connect.Response[v1.SaveTopArtistsResponse], error)
@@ -175,12 +390,32 @@ type SpotifyServiceHandler interface {
 	GetAuthURL(context.Context, *connect.Request[v1.GetAuthURLRequest]) (*connect.Response[v1.GetAuthURLResponse], error)
 	// ExchangeToken exchanges the authorization code for access and refresh tokens.
 	ExchangeToken(context.Context, *connect.Request[v1.ExchangeTokenRequest]) (*connect.Response[v1.ExchangeTokenResponse], error)
-	// GetUserCount retrieves the total number of users in the system.
+	// GetUserCount retrieves the number of users in the currently open round.
 	GetUserCount(context.Context, *connect.Request[v1.GetUserCountRequest]) (*connect.Response[v1.GetUserCountResponse], error)
 	// SearchArtists searches the database for artists matching the query.
 	SearchArtists(context.Context, *connect.Request[v1.SearchArtistsRequest]) (*connect.Response[v1.SearchArtistsResponse], error)
 	// SaveUserSelectedArtists saves manually selected artists for a user.
 	SaveUserSelectedArtists(context.Context, *connect.Request[v1.SaveUserSelectedArtistsRequest]) (*connect.Response[v1.SaveUserSelectedArtistsResponse], error)
+	// GetMyMatch retrieves the match of the user identified by the user token.
+	GetMyMatch(context.Context, *connect.Request[v1.GetMyMatchRequest]) (*connect.Response[v1.GetMyMatchResponse], error)
+	// ExplainMatch breaks down why the user was matched with their partner.
+	ExplainMatch(context.Context, *connect.Request[v1.ExplainMatchRequest]) (*connect.Response[v1.ExplainMatchResponse], error)
+	// SubmitMatchFeedback records how a match went for the user the feedback token was issued to.
+	SubmitMatchFeedback(context.Context, *connect.Request[v1.SubmitMatchFeedbackRequest]) (*connect.Response[v1.SubmitMatchFeedbackResponse], error)
+	// GetMatchContact retrieves the match a response token was issued for, with the partner's contact details once both users accepted.
+	GetMatchContact(context.Context, *connect.Request[v1.GetMatchContactRequest]) (*connect.Response[v1.GetMatchContactResponse], error)
+	// RespondToMatch accepts or declines sharing contact details with the match a response token was issued for.
+	RespondToMatch(context.Context, *connect.Request[v1.RespondToMatchRequest]) (*connect.Response[v1.RespondToMatchResponse], error)
+	// VerifyEmail confirms a manual signup's email address, after which the user counts toward the round.
+	VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error)
+	// ResendVerification sends the verification email of a pending signup again.
+	ResendVerification(context.Context, *connect.Request[v1.ResendVerificationRequest]) (*connect.Response[v1.ResendVerificationResponse], error)
+	// GetMyProfile returns the profile of the user identified by the user token.
+	GetMyProfile(context.Context, *connect.Request[v1.GetMyProfileRequest]) (*connect.Response[v1.GetMyProfileResponse], error)
+	// UpdateProfile changes the user's name, phone number and notification channels while their round is open.
+	UpdateProfile(context.Context, *connect.Request[v1.UpdateProfileRequest]) (*connect.Response[v1.UpdateProfileResponse], error)
+	// UpdateMyArtists replaces the user's artists for their round while it is open.
+	UpdateMyArtists(context.Context, *connect.Request[v1.UpdateMyArtistsRequest]) (*connect.Response[v1.UpdateMyArtistsResponse], error)
 }
 
 // NewSpotifyServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -225,6 +460,66 @@ func NewSpotifyServiceHandler(svc SpotifyServiceHandler, opts ...connect.Handler
 		connect.WithSchema(spotifyServiceSaveUserSelectedArtistsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	spotifyServiceGetMyMatchHandler := connect.NewUnaryHandler(
+		SpotifyServiceGetMyMatchProcedure,
+		svc.GetMyMatch,
+		connect.WithSchema(spotifyServiceGetMyMatchMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	spotifyServiceExplainMatchHandler := connect.NewUnaryHandler(
+		SpotifyServiceExplainMatchProcedure,
+		svc.ExplainMatch,
+		connect.WithSchema(spotifyServiceExplainMatchMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	spotifyServiceSubmitMatchFeedbackHandler := connect.NewUnaryHandler(
+		SpotifyServiceSubmitMatchFeedbackProcedure,
+		svc.SubmitMatchFeedback,
+		connect.WithSchema(spotifyServiceSubmitMatchFeedbackMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	spotifyServiceGetMatchContactHandler := connect.NewUnaryHandler(
+		SpotifyServiceGetMatchContactProcedure,
+		svc.GetMatchContact,
+		connect.WithSchema(spotifyServiceGetMatchContactMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	spotifyServiceRespondToMatchHandler := connect.NewUnaryHandler(
+		SpotifyServiceRespondToMatchProcedure,
+		svc.RespondToMatch,
+		connect.WithSchema(spotifyServiceRespondToMatchMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	spotifyServiceVerifyEmailHandler := connect.NewUnaryHandler(
+		SpotifyServiceVerifyEmailProcedure,
+		svc.VerifyEmail,
+		connect.WithSchema(spotifyServiceVerifyEmailMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	spotifyServiceResendVerificationHandler := connect.NewUnaryHandler(
+		SpotifyServiceResendVerificationProcedure,
+		svc.ResendVerification,
+		connect.WithSchema(spotifyServiceResendVerificationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	spotifyServiceGetMyProfileHandler := connect.NewUnaryHandler(
+		SpotifyServiceGetMyProfileProcedure,
+		svc.GetMyProfile,
+		connect.WithSchema(spotifyServiceGetMyProfileMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	spotifyServiceUpdateProfileHandler := connect.NewUnaryHandler(
+		SpotifyServiceUpdateProfileProcedure,
+		svc.UpdateProfile,
+		connect.WithSchema(spotifyServiceUpdateProfileMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	spotifyServiceUpdateMyArtistsHandler := connect.NewUnaryHandler(
+		SpotifyServiceUpdateMyArtistsProcedure,
+		svc.UpdateMyArtists,
+		connect.WithSchema(spotifyServiceUpdateMyArtistsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/spotify.v1.SpotifyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SpotifyServiceSaveTopArtistsProcedure:
@@ -239,6 +534,26 @@ func NewSpotifyServiceHandler(svc SpotifyServiceHandler, opts ...connect.Handler
 			spotifyServiceSearchArtistsHandler.ServeHTTP(w, r)
 		case SpotifyServiceSaveUserSelectedArtistsProcedure:
 			spotifyServiceSaveUserSelectedArtistsHandler.ServeHTTP(w, r)
+		case SpotifyServiceGetMyMatchProcedure:
+			spotifyServiceGetMyMatchHandler.ServeHTTP(w, r)
+		case SpotifyServiceExplainMatchProcedure:
+			spotifyServiceExplainMatchHandler.ServeHTTP(w, r)
+		case SpotifyServiceSubmitMatchFeedbackProcedure:
+			spotifyServiceSubmitMatchFeedbackHandler.ServeHTTP(w, r)
+		case SpotifyServiceGetMatchContactProcedure:
+			spotifyServiceGetMatchContactHandler.ServeHTTP(w, r)
+		case SpotifyServiceRespondToMatchProcedure:
+			spotifyServiceRespondToMatchHandler.ServeHTTP(w, r)
+		case SpotifyServiceVerifyEmailProcedure:
+			spotifyServiceVerifyEmailHandler.ServeHTTP(w, r)
+		case SpotifyServiceResendVerificationProcedure:
+			spotifyServiceResendVerificationHandler.ServeHTTP(w, r)
+		case SpotifyServiceGetMyProfileProcedure:
+			spotifyServiceGetMyProfileHandler.ServeHTTP(w, r)
+		case SpotifyServiceUpdateProfileProcedure:
+			spotifyServiceUpdateProfileHandler.ServeHTTP(w, r)
+		case SpotifyServiceUpdateMyArtistsProcedure:
+			spotifyServiceUpdateMyArtistsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -271,3 +586,337 @@ func (UnimplementedSpotifyServiceHandler) SearchArtists(context.Context, *connec
 func (UnimplementedSpotifyServiceHandler) SaveUserSelectedArtists(context.Context, *connect.Request[v1.SaveUserSelectedArtistsRequest]) (*connect.Response[v1.SaveUserSelectedArtistsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.SpotifyService.SaveUserSelectedArtists is not implemented"))
 }
+
+func (UnimplementedSpotifyServiceHandler) GetMyMatch(context.Context, *connect.Request[v1.GetMyMatchRequest]) (*connect.Response[v1.GetMyMatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.SpotifyService.GetMyMatch is not implemented"))
+}
+
+func (UnimplementedSpotifyServiceHandler) ExplainMatch(context.Context, *connect.Request[v1.ExplainMatchRequest]) (*connect.Response[v1.ExplainMatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.SpotifyService.ExplainMatch is not implemented"))
+}
+
+func (UnimplementedSpotifyServiceHandler) SubmitMatchFeedback(context.Context, *connect.Request[v1.SubmitMatchFeedbackRequest]) (*connect.Response[v1.SubmitMatchFeedbackResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.SpotifyService.SubmitMatchFeedback is not implemented"))
+}
+
+func (UnimplementedSpotifyServiceHandler) GetMatchContact(context.Context, *connect.Request[v1.GetMatchContactRequest]) (*connect.Response[v1.GetMatchContactResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.SpotifyService.GetMatchContact is not implemented"))
+}
+
+func (UnimplementedSpotifyServiceHandler) RespondToMatch(context.Context, *connect.Request[v1.RespondToMatchRequest]) (*connect.Response[v1.RespondToMatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.SpotifyService.RespondToMatch is not implemented"))
+}
+
+func (UnimplementedSpotifyServiceHandler) VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.SpotifyService.VerifyEmail is not implemented"))
+}
+
+func (UnimplementedSpotifyServiceHandler) ResendVerification(context.Context, *connect.Request[v1.ResendVerificationRequest]) (*connect.Response[v1.ResendVerificationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.SpotifyService.ResendVerification is not implemented"))
+}
+
+func (UnimplementedSpotifyServiceHandler) GetMyProfile(context.Context, *connect.Request[v1.GetMyProfileRequest]) (*connect.Response[v1.GetMyProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.SpotifyService.GetMyProfile is not implemented"))
+}
+
+func (UnimplementedSpotifyServiceHandler) UpdateProfile(context.Context, *connect.Request[v1.UpdateProfileRequest]) (*connect.Response[v1.UpdateProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.SpotifyService.UpdateProfile is not implemented"))
+}
+
+func (UnimplementedSpotifyServiceHandler) UpdateMyArtists(context.Context, *connect.Request[v1.UpdateMyArtistsRequest]) (*connect.Response[v1.UpdateMyArtistsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.SpotifyService.UpdateMyArtists is not implemented"))
+}
+
+// RoundServiceClient is a client for the spotify.v1.RoundService service.
+type RoundServiceClient interface {
+	// CreateRound creates a new round in the draft state.
+	CreateRound(context.Context, *connect.Request[v1.CreateRoundRequest]) (*connect.Response[v1.CreateRoundResponse], error)
+	// OpenRound starts accepting signups for a draft round.
+	OpenRound(context.Context, *connect.Request[v1.OpenRoundRequest]) (*connect.Response[v1.OpenRoundResponse], error)
+	// CloseRound stops accepting signups for an open round.
+	CloseRound(context.Context, *connect.Request[v1.CloseRoundRequest]) (*connect.Response[v1.CloseRoundResponse], error)
+	// GetRound retrieves a round along with its current user count.
+	GetRound(context.Context, *connect.Request[v1.GetRoundRequest]) (*connect.Response[v1.GetRoundResponse], error)
+	// ListRounds retrieves all rounds, newest first.
+	ListRounds(context.Context, *connect.Request[v1.ListRoundsRequest]) (*connect.Response[v1.ListRoundsResponse], error)
+	// UpdateRoundSettings changes how a round will be matched.
+	UpdateRoundSettings(context.Context, *connect.Request[v1.UpdateRoundSettingsRequest]) (*connect.Response[v1.UpdateRoundSettingsResponse], error)
+	// SimulateMatching runs matching for a round without saving the results.
+	SimulateMatching(context.Context, *connect.Request[v1.SimulateMatchingRequest]) (*connect.Response[v1.SimulateMatchingResponse], error)
+	// GetRoundFeedback summarizes the feedback on a round's matches by match score.
+	GetRoundFeedback(context.Context, *connect.Request[v1.GetRoundFeedbackRequest]) (*connect.Response[v1.GetRoundFeedbackResponse], error)
+	// RevokeMatchLinks revokes every link a user was sent for their match and issues new tokens.
+	RevokeMatchLinks(context.Context, *connect.Request[v1.RevokeMatchLinksRequest]) (*connect.Response[v1.RevokeMatchLinksResponse], error)
+}
+
+// NewRoundServiceClient constructs a client for the spotify.v1.RoundService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRoundServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RoundServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &roundServiceClient{
+		createRound: connect.NewClient[v1.CreateRoundRequest, v1.CreateRoundResponse](
+			httpClient,
+			baseURL+RoundServiceCreateRoundProcedure,
+			connect.WithSchema(roundServiceCreateRoundMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		openRound: connect.NewClient[v1.OpenRoundRequest, v1.OpenRoundResponse](
+			httpClient,
+			baseURL+RoundServiceOpenRoundProcedure,
+			connect.WithSchema(roundServiceOpenRoundMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		closeRound: connect.NewClient[v1.CloseRoundRequest, v1.CloseRoundResponse](
+			httpClient,
+			baseURL+RoundServiceCloseRoundProcedure,
+			connect.WithSchema(roundServiceCloseRoundMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getRound: connect.NewClient[v1.GetRoundRequest, v1.GetRoundResponse](
+			httpClient,
+			baseURL+RoundServiceGetRoundProcedure,
+			connect.WithSchema(roundServiceGetRoundMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listRounds: connect.NewClient[v1.ListRoundsRequest, v1.ListRoundsResponse](
+			httpClient,
+			baseURL+RoundServiceListRoundsProcedure,
+			connect.WithSchema(roundServiceListRoundsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateRoundSettings: connect.NewClient[v1.UpdateRoundSettingsRequest, v1.UpdateRoundSettingsResponse](
+			httpClient,
+			baseURL+RoundServiceUpdateRoundSettingsProcedure,
+			connect.WithSchema(roundServiceUpdateRoundSettingsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		simulateMatching: connect.NewClient[v1.SimulateMatchingRequest, v1.SimulateMatchingResponse](
+			httpClient,
+			baseURL+RoundServiceSimulateMatchingProcedure,
+			connect.WithSchema(roundServiceSimulateMatchingMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getRoundFeedback: connect.NewClient[v1.GetRoundFeedbackRequest, v1.GetRoundFeedbackResponse](
+			httpClient,
+			baseURL+RoundServiceGetRoundFeedbackProcedure,
+			connect.WithSchema(roundServiceGetRoundFeedbackMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		revokeMatchLinks: connect.NewClient[v1.RevokeMatchLinksRequest, v1.RevokeMatchLinksResponse](
+			httpClient,
+			baseURL+RoundServiceRevokeMatchLinksProcedure,
+			connect.WithSchema(roundServiceRevokeMatchLinksMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// roundServiceClient implements RoundServiceClient.
+type roundServiceClient struct {
+	createRound         *connect.Client[v1.CreateRoundRequest, v1.CreateRoundResponse]
+	openRound           *connect.Client[v1.OpenRoundRequest, v1.OpenRoundResponse]
+	closeRound          *connect.Client[v1.CloseRoundRequest, v1.CloseRoundResponse]
+	getRound            *connect.Client[v1.GetRoundRequest, v1.GetRoundResponse]
+	listRounds          *connect.Client[v1.ListRoundsRequest, v1.ListRoundsResponse]
+	updateRoundSettings *connect.Client[v1.UpdateRoundSettingsRequest, v1.UpdateRoundSettingsResponse]
+	simulateMatching    *connect.Client[v1.SimulateMatchingRequest, v1.SimulateMatchingResponse]
+	getRoundFeedback    *connect.Client[v1.GetRoundFeedbackRequest, v1.GetRoundFeedbackResponse]
+	revokeMatchLinks    *connect.Client[v1.RevokeMatchLinksRequest, v1.RevokeMatchLinksResponse]
+}
+
+// CreateRound calls spotify.v1.RoundService.CreateRound.
+func (c *roundServiceClient) CreateRound(ctx context.Context, req *connect.Request[v1.CreateRoundRequest]) (*connect.Response[v1.CreateRoundResponse], error) {
+	return c.createRound.CallUnary(ctx, req)
+}
+
+// OpenRound calls spotify.v1.RoundService.OpenRound.
+func (c *roundServiceClient) OpenRound(ctx context.Context, req *connect.Request[v1.OpenRoundRequest]) (*connect.Response[v1.OpenRoundResponse], error) {
+	return c.openRound.CallUnary(ctx, req)
+}
+
+// CloseRound calls spotify.v1.RoundService.CloseRound.
+func (c *roundServiceClient) CloseRound(ctx context.Context, req *connect.Request[v1.CloseRoundRequest]) (*connect.Response[v1.CloseRoundResponse], error) {
+	return c.closeRound.CallUnary(ctx, req)
+}
+
+// GetRound calls spotify.v1.RoundService.GetRound.
+func (c *roundServiceClient) GetRound(ctx context.Context, req *connect.Request[v1.GetRoundRequest]) (*connect.Response[v1.GetRoundResponse], error) {
+	return c.getRound.CallUnary(ctx, req)
+}
+
+// ListRounds calls spotify.v1.RoundService.ListRounds.
+func (c *roundServiceClient) ListRounds(ctx context.Context, req *connect.Request[v1.ListRoundsRequest]) (*connect.Response[v1.ListRoundsResponse], error) {
+	return c.listRounds.CallUnary(ctx, req)
+}
+
+// UpdateRoundSettings calls spotify.v1.RoundService.UpdateRoundSettings.
+func (c *roundServiceClient) UpdateRoundSettings(ctx context.Context, req *connect.Request[v1.UpdateRoundSettingsRequest]) (*connect.Response[v1.UpdateRoundSettingsResponse], error) {
+	return c.updateRoundSettings.CallUnary(ctx, req)
+}
+
+// SimulateMatching calls spotify.v1.RoundService.SimulateMatching.
+func (c *roundServiceClient) SimulateMatching(ctx context.Context, req *connect.Request[v1.SimulateMatchingRequest]) (*connect.Response[v1.SimulateMatchingResponse], error) {
+	return c.simulateMatching.CallUnary(ctx, req)
+}
+
+// GetRoundFeedback calls spotify.v1.RoundService.GetRoundFeedback.
+func (c *roundServiceClient) GetRoundFeedback(ctx context.Context, req *connect.Request[v1.GetRoundFeedbackRequest]) (*connect.Response[v1.GetRoundFeedbackResponse], error) {
+	return c.getRoundFeedback.CallUnary(ctx, req)
+}
+
+// RevokeMatchLinks calls spotify.v1.RoundService.RevokeMatchLinks.
+func (c *roundServiceClient) RevokeMatchLinks(ctx context.Context, req *connect.Request[v1.RevokeMatchLinksRequest]) (*connect.Response[v1.RevokeMatchLinksResponse], error) {
+	return c.revokeMatchLinks.CallUnary(ctx, req)
+}
+
+// RoundServiceHandler is an implementation of the spotify.v1.RoundService service.
+type RoundServiceHandler interface {
+	// CreateRound creates a new round in the draft state.
+	CreateRound(context.Context, *connect.Request[v1.CreateRoundRequest]) (*connect.Response[v1.CreateRoundResponse], error)
+	// OpenRound starts accepting signups for a draft round.
+	OpenRound(context.Context, *connect.Request[v1.OpenRoundRequest]) (*connect.Response[v1.OpenRoundResponse], error)
+	// CloseRound stops accepting signups for an open round.
+	CloseRound(context.Context, *connect.Request[v1.CloseRoundRequest]) (*connect.Response[v1.CloseRoundResponse], error)
+	// GetRound retrieves a round along with its current user count.
+	GetRound(context.Context, *connect.Request[v1.GetRoundRequest]) (*connect.Response[v1.GetRoundResponse], error)
+	// ListRounds retrieves all rounds, newest first.
+	ListRounds(context.Context, *connect.Request[v1.ListRoundsRequest]) (*connect.Response[v1.ListRoundsResponse], error)
+	// UpdateRoundSettings changes how a round will be matched.
+	UpdateRoundSettings(context.Context, *connect.Request[v1.UpdateRoundSettingsRequest]) (*connect.Response[v1.UpdateRoundSettingsResponse], error)
+	// SimulateMatching runs matching for a round without saving the results.
+	SimulateMatching(context.Context, *connect.Request[v1.SimulateMatchingRequest]) (*connect.Response[v1.SimulateMatchingResponse], error)
+	// GetRoundFeedback summarizes the feedback on a round's matches by match score.
+	GetRoundFeedback(context.Context, *connect.Request[v1.GetRoundFeedbackRequest]) (*connect.Response[v1.GetRoundFeedbackResponse], error)
+	// RevokeMatchLinks revokes every link a user was sent for their match and issues new tokens.
+	RevokeMatchLinks(context.Context, *connect.Request[v1.RevokeMatchLinksRequest]) (*connect.Response[v1.RevokeMatchLinksResponse], error)
+}
+
+// NewRoundServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRoundServiceHandler(svc RoundServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	roundServiceCreateRoundHandler := connect.NewUnaryHandler(
+		RoundServiceCreateRoundProcedure,
+		svc.CreateRound,
+		connect.WithSchema(roundServiceCreateRoundMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	roundServiceOpenRoundHandler := connect.NewUnaryHandler(
+		RoundServiceOpenRoundProcedure,
+		svc.OpenRound,
+		connect.WithSchema(roundServiceOpenRoundMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	roundServiceCloseRoundHandler := connect.NewUnaryHandler(
+		RoundServiceCloseRoundProcedure,
+		svc.CloseRound,
+		connect.WithSchema(roundServiceCloseRoundMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	roundServiceGetRoundHandler := connect.NewUnaryHandler(
+		RoundServiceGetRoundProcedure,
+		svc.GetRound,
+		connect.WithSchema(roundServiceGetRoundMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	roundServiceListRoundsHandler := connect.NewUnaryHandler(
+		RoundServiceListRoundsProcedure,
+		svc.ListRounds,
+		connect.WithSchema(roundServiceListRoundsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	roundServiceUpdateRoundSettingsHandler := connect.NewUnaryHandler(
+		RoundServiceUpdateRoundSettingsProcedure,
+		svc.UpdateRoundSettings,
+		connect.WithSchema(roundServiceUpdateRoundSettingsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	roundServiceSimulateMatchingHandler := connect.NewUnaryHandler(
+		RoundServiceSimulateMatchingProcedure,
+		svc.SimulateMatching,
+		connect.WithSchema(roundServiceSimulateMatchingMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	roundServiceGetRoundFeedbackHandler := connect.NewUnaryHandler(
+		RoundServiceGetRoundFeedbackProcedure,
+		svc.GetRoundFeedback,
+		connect.WithSchema(roundServiceGetRoundFeedbackMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	roundServiceRevokeMatchLinksHandler := connect.NewUnaryHandler(
+		RoundServiceRevokeMatchLinksProcedure,
+		svc.RevokeMatchLinks,
+		connect.WithSchema(roundServiceRevokeMatchLinksMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/spotify.v1.RoundService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RoundServiceCreateRoundProcedure:
+			roundServiceCreateRoundHandler.ServeHTTP(w, r)
+		case RoundServiceOpenRoundProcedure:
+			roundServiceOpenRoundHandler.ServeHTTP(w, r)
+		case RoundServiceCloseRoundProcedure:
+			roundServiceCloseRoundHandler.ServeHTTP(w, r)
+		case RoundServiceGetRoundProcedure:
+			roundServiceGetRoundHandler.ServeHTTP(w, r)
+		case RoundServiceListRoundsProcedure:
+			roundServiceListRoundsHandler.ServeHTTP(w, r)
+		case RoundServiceUpdateRoundSettingsProcedure:
+			roundServiceUpdateRoundSettingsHandler.ServeHTTP(w, r)
+		case RoundServiceSimulateMatchingProcedure:
+			roundServiceSimulateMatchingHandler.ServeHTTP(w, r)
+		case RoundServiceGetRoundFeedbackProcedure:
+			roundServiceGetRoundFeedbackHandler.ServeHTTP(w, r)
+		case RoundServiceRevokeMatchLinksProcedure:
+			roundServiceRevokeMatchLinksHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRoundServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRoundServiceHandler struct{}
+
+func (UnimplementedRoundServiceHandler) CreateRound(context.Context, *connect.Request[v1.CreateRoundRequest]) (*connect.Response[v1.CreateRoundResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.RoundService.CreateRound is not implemented"))
+}
+
+func (UnimplementedRoundServiceHandler) OpenRound(context.Context, *connect.Request[v1.OpenRoundRequest]) (*connect.Response[v1.OpenRoundResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.RoundService.OpenRound is not implemented"))
+}
+
+func (UnimplementedRoundServiceHandler) CloseRound(context.Context, *connect.Request[v1.CloseRoundRequest]) (*connect.Response[v1.CloseRoundResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.RoundService.CloseRound is not implemented"))
+}
+
+func (UnimplementedRoundServiceHandler) GetRound(context.Context, *connect.Request[v1.GetRoundRequest]) (*connect.Response[v1.GetRoundResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.RoundService.GetRound is not implemented"))
+}
+
+func (UnimplementedRoundServiceHandler) ListRounds(context.Context, *connect.Request[v1.ListRoundsRequest]) (*connect.Response[v1.ListRoundsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.RoundService.ListRounds is not implemented"))
+}
+
+func (UnimplementedRoundServiceHandler) UpdateRoundSettings(context.Context, *connect.Request[v1.UpdateRoundSettingsRequest]) (*connect.Response[v1.UpdateRoundSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.RoundService.UpdateRoundSettings is not implemented"))
+}
+
+func (UnimplementedRoundServiceHandler) SimulateMatching(context.Context, *connect.Request[v1.SimulateMatchingRequest]) (*connect.Response[v1.SimulateMatchingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.RoundService.SimulateMatching is not implemented"))
+}
+
+func (UnimplementedRoundServiceHandler) GetRoundFeedback(context.Context, *connect.Request[v1.GetRoundFeedbackRequest]) (*connect.Response[v1.GetRoundFeedbackResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.RoundService.GetRoundFeedback is not implemented"))
+}
+
+func (UnimplementedRoundServiceHandler) RevokeMatchLinks(context.Context, *connect.Request[v1.RevokeMatchLinksRequest]) (*connect.Response[v1.RevokeMatchLinksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("spotify.v1.RoundService.RevokeMatchLinks is not implemented"))
+}
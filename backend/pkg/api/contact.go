@@ -0,0 +1,217 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	spotifyv1 "github.com/sukhmai/spotify-match/gen/spotify/v1"
+	"github.com/sukhmai/spotify-match/pkg/db"
+	"github.com/sukhmai/spotify-match/pkg/notify"
+	"github.com/sukhmai/spotify-match/pkg/token"
+)
+
+// GetMatchContact returns the match a response token was issued for. The
+// partner's contact details are only included once both users accepted.
+func (s *SpotifyServer) GetMatchContact(ctx context.Context,
+	req *connect.Request[spotifyv1.GetMatchContactRequest],
+) (*connect.Response[spotifyv1.GetMatchContactResponse], error) {
+	match, userID, err := s.responseMatch(ctx, req.Msg.ResponseToken)
+	if err != nil {
+		return nil, err
+	}
+
+	contact, err := s.matchContact(ctx, match, userID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&spotifyv1.GetMatchContactResponse{Contact: contact}), nil
+}
+
+// RespondToMatch records whether the user the response token was issued for
+// wants to share contact details with their match. When both accepted, the
+// details are revealed to both, who are notified if a sender is configured.
+// Responses cannot be changed once the details have been revealed.
+func (s *SpotifyServer) RespondToMatch(ctx context.Context,
+	req *connect.Request[spotifyv1.RespondToMatchRequest],
+) (*connect.Response[spotifyv1.RespondToMatchResponse], error) {
+	match, userID, err := s.responseMatch(ctx, req.Msg.ResponseToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if match.RevealedAt == nil {
+		notifications, err := s.contactNotifications(ctx, match)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to render contact notifications: %w", err))
+		}
+		match, err = s.dbClient.RespondToMatch(ctx, match.ID, userID, req.Msg.Accept, notifications)
+		if errors.Is(err, db.ErrContactRevealed) {
+			// The partner's acceptance revealed the match since it was loaded
+			match, err = s.dbClient.GetMatch(ctx, match.ID)
+		}
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	if match.RevealedAt != nil && !req.Msg.Accept {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, db.ErrContactRevealed)
+	}
+
+	contact, err := s.matchContact(ctx, match, userID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&spotifyv1.RespondToMatchResponse{Contact: contact}), nil
+}
+
+// responseMatch verifies a response token and returns the match and user it
+// was issued for
+func (s *SpotifyServer) responseMatch(ctx context.Context, responseToken string) (db.Match, int, error) {
+	if responseToken == "" {
+		return db.Match{}, 0, connect.NewError(connect.CodeUnauthenticated, errors.New("response_token is required"))
+	}
+	t, err := s.tokens.VerifyResponse(responseToken)
+	if err != nil {
+		return db.Match{}, 0, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	match, err := s.tokenMatch(ctx, t)
+	if err != nil {
+		return db.Match{}, 0, err
+	}
+	return match, t.UserID, nil
+}
+
+// tokenMatch returns the match a match token was issued for, checking that its
+// user is part of the match and that their links have not been revoked since
+func (s *Server) tokenMatch(ctx context.Context, t token.MatchToken) (db.Match, error) {
+	match, err := s.dbClient.GetMatch(ctx, t.MatchID)
+	if errors.Is(err, db.ErrMatchNotFound) {
+		return db.Match{}, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		return db.Match{}, connect.NewError(connect.CodeInternal, err)
+	}
+	if match.UserAID != t.UserID && match.UserBID != t.UserID {
+		return db.Match{}, connect.NewError(connect.CodePermissionDenied, errors.New("user is not part of this match"))
+	}
+	if match.LinkGeneration(t.UserID) != t.Generation {
+		return db.Match{}, connect.NewError(connect.CodeUnauthenticated, errors.New("this link has been revoked"))
+	}
+	return match, nil
+}
+
+// matchContact converts a match to the response format as seen by one of its users
+func (s *SpotifyServer) matchContact(ctx context.Context, match db.Match, userID int) (*spotifyv1.MatchContact, error) {
+	partner, err := s.dbClient.GetUser(ctx, match.PartnerID(userID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get matched user: %w", err))
+	}
+	commonArtists, err := s.artistInfos(ctx, match.SharedArtistIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get common artists: %w", err))
+	}
+
+	contact := &spotifyv1.MatchContact{
+		RoundId:          int32(match.RoundID),
+		PartnerFirstName: partner.FirstName,
+		PartnerLastName:  partner.LastName,
+		MatchScore:       int32(match.MatchScore),
+		CommonArtists:    commonArtists,
+		Accepted:         match.Response(userID) == db.ContactResponseAccepted,
+	}
+	switch {
+	case match.RevealedAt != nil:
+		contact.Status = spotifyv1.ContactStatus_CONTACT_STATUS_REVEALED
+		contact.PartnerEmail = partner.Email
+		contact.PartnerPhone = partner.PhoneNumber
+	case match.UserAResponse == db.ContactResponseDeclined || match.UserBResponse == db.ContactResponseDeclined:
+		contact.Status = spotifyv1.ContactStatus_CONTACT_STATUS_DECLINED
+	case contact.Accepted:
+		contact.Status = spotifyv1.ContactStatus_CONTACT_STATUS_WAITING
+	default:
+		contact.Status = spotifyv1.ContactStatus_CONTACT_STATUS_PENDING
+	}
+	return contact, nil
+}
+
+// contactNotifications renders the notifications telling both users of a
+// match that they can reach each other, which are enqueued if the response
+// reveals it. There are none without a configured sender.
+func (s *SpotifyServer) contactNotifications(ctx context.Context, match db.Match) ([]db.Notification, error) {
+	if len(s.contactNotifiers) == 0 {
+		return nil, nil
+	}
+
+	users := make(map[int]db.User, 2)
+	for _, userID := range []int{match.UserAID, match.UserBID} {
+		user, err := s.dbClient.GetUser(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		users[userID] = user
+	}
+	artists, err := s.dbClient.GetArtistsByInternalIDs(ctx, match.SharedArtistIDs)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, artistID := range match.SharedArtistIDs {
+		if artist, ok := artists[artistID]; ok {
+			names = append(names, artist.Name)
+		}
+	}
+
+	emails, err := notify.NewContactEmails(match, users, names, s.contactLinks)
+	if err != nil {
+		return nil, err
+	}
+	var notifications []db.Notification
+	for _, notifier := range s.contactNotifiers {
+		rendered, err := notifier.Notifications(emails)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, rendered...)
+	}
+	return notifications, nil
+}
+
+// RevokeMatchLinks revokes every link a user was sent for their match, for
+// when a link was forwarded or leaked, and returns tokens for new links
+func (s *RoundServer) RevokeMatchLinks(ctx context.Context,
+	req *connect.Request[spotifyv1.RevokeMatchLinksRequest],
+) (*connect.Response[spotifyv1.RevokeMatchLinksResponse], error) {
+	if err := s.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+
+	match, err := s.dbClient.RevokeMatchLinks(ctx, int(req.Msg.MatchId), int(req.Msg.UserId))
+	if errors.Is(err, db.ErrMatchNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("user has no match with this ID"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	t := token.MatchToken{
+		MatchID:    match.ID,
+		UserID:     int(req.Msg.UserId),
+		Generation: match.LinkGeneration(int(req.Msg.UserId)),
+	}
+	responseToken, err := s.tokens.SignResponse(t)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to issue response token: %w", err))
+	}
+	feedbackToken, err := s.tokens.SignFeedback(t)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to issue feedback token: %w", err))
+	}
+
+	return connect.NewResponse(&spotifyv1.RevokeMatchLinksResponse{
+		LinkGeneration: int32(t.Generation),
+		ResponseToken:  responseToken,
+		FeedbackToken:  feedbackToken,
+	}), nil
+}
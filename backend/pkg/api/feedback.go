@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	spotifyv1 "github.com/sukhmai/spotify-match/gen/spotify/v1"
+	"github.com/sukhmai/spotify-match/pkg/db"
+)
+
+// maxFeedbackComment is the longest feedback comment accepted, in characters
+const maxFeedbackComment = 2000
+
+// SubmitMatchFeedback records how a match went for the user the feedback token
+// was issued to. Submitting again replaces the earlier feedback.
+func (s *SpotifyServer) SubmitMatchFeedback(ctx context.Context,
+	req *connect.Request[spotifyv1.SubmitMatchFeedbackRequest],
+) (*connect.Response[spotifyv1.SubmitMatchFeedbackResponse], error) {
+	if req.Msg.FeedbackToken == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("feedback_token is required"))
+	}
+	t, err := s.tokens.VerifyFeedback(req.Msg.FeedbackToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	if req.Msg.Rating < 1 || req.Msg.Rating > 5 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("rating must be between 1 and 5"))
+	}
+	if utf8.RuneCountInString(req.Msg.Comment) > maxFeedbackComment {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("comment must be at most 2000 characters"))
+	}
+
+	match, err := s.tokenMatch(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.dbClient.SaveMatchFeedback(ctx, db.MatchFeedback{
+		MatchID:   t.MatchID,
+		UserID:    t.UserID,
+		Connected: req.Msg.Connected,
+		Rating:    int(req.Msg.Rating),
+		Comment:   req.Msg.Comment,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&spotifyv1.SubmitMatchFeedbackResponse{
+		RoundId: int32(match.RoundID),
+	}), nil
+}
+
+// GetRoundFeedback summarizes the feedback on a round's matches by match score,
+// to show whether higher scoring matches turn out better
+func (s *RoundServer) GetRoundFeedback(ctx context.Context,
+	req *connect.Request[spotifyv1.GetRoundFeedbackRequest],
+) (*connect.Response[spotifyv1.GetRoundFeedbackResponse], error) {
+	if err := s.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+
+	var round db.Round
+	var err error
+	if req.Msg.RoundId != 0 {
+		round, err = s.dbClient.GetRound(ctx, int(req.Msg.RoundId))
+	} else {
+		round, err = s.dbClient.GetLatestMatchedRound(ctx)
+	}
+	if err != nil {
+		return nil, roundError(err)
+	}
+
+	feedback, err := s.dbClient.GetRoundFeedback(ctx, round.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &spotifyv1.GetRoundFeedbackResponse{
+		RoundId:                int32(round.ID),
+		MatchCount:             int32(feedback.Matches),
+		ResponseCount:          int32(feedback.Responses),
+		ConnectedCount:         int32(feedback.Connected),
+		MeanRating:             feedback.MeanRating,
+		ScoreRatingCorrelation: feedback.ScoreRatingCorrelation,
+		Comments:               feedback.Comments,
+	}
+	for _, b := range feedback.Buckets {
+		resp.Buckets = append(resp.Buckets, &spotifyv1.FeedbackBucket{
+			MinScore:       int32(b.MinScore),
+			MaxScore:       int32(b.MaxScore),
+			MatchCount:     int32(b.Matches),
+			ResponseCount:  int32(b.Responses),
+			ConnectedCount: int32(b.Connected),
+			MeanRating:     b.MeanRating,
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,259 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	spotifyv1 "github.com/sukhmai/spotify-match/gen/spotify/v1"
+	"github.com/sukhmai/spotify-match/pkg/db"
+	"github.com/sukhmai/spotify-match/pkg/token"
+)
+
+// User tokens are handed out at signup and identify the user in later requests
+const (
+	userTokenKind = "user"
+	userTokenTTL  = 180 * 24 * time.Hour
+)
+
+// issueUserToken creates a token identifying the given user
+func (s *Server) issueUserToken(userID string) (string, error) {
+	userToken, err := s.tokens.Sign(userTokenKind, userID, userTokenTTL)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("failed to issue user token: %w", err))
+	}
+	return userToken, nil
+}
+
+// userIDFromToken verifies a user token and returns the user ID it was issued for
+func (s *Server) userIDFromToken(userToken string) (int, error) {
+	if userToken == "" {
+		return 0, connect.NewError(connect.CodeUnauthenticated, errors.New("user_token is required"))
+	}
+	claims, err := s.tokens.Verify(userToken, userTokenKind)
+	if err != nil {
+		return 0, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	userID, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return 0, connect.NewError(connect.CodeUnauthenticated, token.ErrInvalidToken)
+	}
+	return userID, nil
+}
+
+// artistInfo converts a database artist to its response format
+func artistInfo(artist db.Artist) *spotifyv1.ArtistInfo {
+	info := &spotifyv1.ArtistInfo{
+		Id:         artist.ID,
+		Name:       artist.Name,
+		Genres:     artist.Genres,
+		Popularity: int32(artist.Popularity),
+		SpotifyUrl: artist.SpotifyURL,
+	}
+	for _, img := range artist.Images {
+		info.Images = append(info.Images, &spotifyv1.ArtistImage{
+			Url:    img.URL,
+			Height: int32(img.Height),
+			Width:  int32(img.Width),
+		})
+	}
+	return info
+}
+
+// artistInfos looks up the given internal artist IDs and converts them to
+// response format, preserving their order
+func (s *Server) artistInfos(ctx context.Context, artistIDs []int) ([]*spotifyv1.ArtistInfo, error) {
+	artists, err := s.dbClient.GetArtistsByInternalIDs(ctx, artistIDs)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]*spotifyv1.ArtistInfo, 0, len(artistIDs))
+	for _, artistID := range artistIDs {
+		if artist, ok := artists[artistID]; ok {
+			infos = append(infos, artistInfo(artist))
+		}
+	}
+	return infos, nil
+}
+
+// userRound identifies the user from their token and looks up the requested
+// round, defaulting to the latest round the user joined
+func (s *Server) userRound(ctx context.Context, userToken string, roundID int32) (int, db.Round, error) {
+	userID, err := s.userIDFromToken(userToken)
+	if err != nil {
+		return 0, db.Round{}, err
+	}
+
+	id := int(roundID)
+	if id == 0 {
+		id, err = s.dbClient.GetUserLatestRoundID(ctx, userID)
+		if err != nil {
+			return 0, db.Round{}, roundError(err)
+		}
+	}
+
+	round, err := s.dbClient.GetRound(ctx, id)
+	if err != nil {
+		return 0, db.Round{}, roundError(err)
+	}
+	return userID, round, nil
+}
+
+// GetMyMatch retrieves the match of the user identified by the user token
+func (s *SpotifyServer) GetMyMatch(ctx context.Context,
+	req *connect.Request[spotifyv1.GetMyMatchRequest],
+) (*connect.Response[spotifyv1.GetMyMatchResponse], error) {
+	userID, round, err := s.userRound(ctx, req.Msg.UserToken, req.Msg.RoundId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &spotifyv1.GetMyMatchResponse{RoundId: int32(round.ID)}
+	if round.MatchedAt == nil {
+		resp.Status = spotifyv1.MatchStatus_MATCH_STATUS_PENDING
+		return connect.NewResponse(resp), nil
+	}
+
+	match, err := s.dbClient.GetUserMatch(ctx, round.ID, userID)
+	if errors.Is(err, db.ErrMatchNotFound) {
+		return s.getMyMatchGroup(ctx, resp, userID)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	partner, err := s.dbClient.GetUser(ctx, match.PartnerID(userID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get partner: %w", err))
+	}
+
+	commonArtists, err := s.artistInfos(ctx, match.SharedArtistIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get common artists: %w", err))
+	}
+
+	resp.Status = spotifyv1.MatchStatus_MATCH_STATUS_MATCHED
+	resp.PartnerFirstName = partner.FirstName
+	resp.PartnerLastName = partner.LastName
+	resp.MatchScore = int32(match.MatchScore)
+	resp.Similarity = match.Similarity
+	resp.CommonArtists = commonArtists
+	resp.CommonGenres = match.SharedGenres
+	return connect.NewResponse(resp), nil
+}
+
+// getMyMatchGroup fills in the user's group for rounds matched in group mode
+func (s *SpotifyServer) getMyMatchGroup(ctx context.Context, resp *spotifyv1.GetMyMatchResponse, userID int,
+) (*connect.Response[spotifyv1.GetMyMatchResponse], error) {
+	group, err := s.dbClient.GetUserMatchGroup(ctx, int(resp.RoundId), userID)
+	if errors.Is(err, db.ErrMatchNotFound) {
+		return s.getMyCarryover(ctx, resp, userID)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	for _, partnerID := range group.PartnerIDs(userID) {
+		partner, err := s.dbClient.GetUser(ctx, partnerID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get group member: %w", err))
+		}
+		resp.GroupMembers = append(resp.GroupMembers, &spotifyv1.MatchPartner{
+			FirstName: partner.FirstName,
+			LastName:  partner.LastName,
+		})
+	}
+
+	commonArtists, err := s.artistInfos(ctx, group.SharedArtistIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get common artists: %w", err))
+	}
+
+	resp.Status = spotifyv1.MatchStatus_MATCH_STATUS_MATCHED
+	resp.MatchScore = int32(group.MatchScore)
+	resp.Similarity = group.Similarity
+	resp.CommonArtists = commonArtists
+	resp.CommonGenres = group.SharedGenres
+	return connect.NewResponse(resp), nil
+}
+
+// ExplainMatch breaks down why the user was matched with their partner
+func (s *SpotifyServer) ExplainMatch(ctx context.Context,
+	req *connect.Request[spotifyv1.ExplainMatchRequest],
+) (*connect.Response[spotifyv1.ExplainMatchResponse], error) {
+	userID, round, err := s.userRound(ctx, req.Msg.UserToken, req.Msg.RoundId)
+	if err != nil {
+		return nil, err
+	}
+
+	if round.MatchedAt == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			errors.New("matching has not run for this round yet"))
+	}
+
+	match, err := s.dbClient.GetUserMatch(ctx, round.ID, userID)
+	if errors.Is(err, db.ErrMatchNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("you were not matched with a partner in this round"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	myRanks, err := s.dbClient.GetUserArtistRanks(ctx, round.ID, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	partnerRanks, err := s.dbClient.GetUserArtistRanks(ctx, round.ID, match.PartnerID(userID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	artists, err := s.dbClient.GetArtistsByInternalIDs(ctx, match.SharedArtistIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get shared artists: %w", err))
+	}
+
+	resp := &spotifyv1.ExplainMatchResponse{
+		RoundId:           int32(round.ID),
+		MatchScore:        int32(match.MatchScore),
+		Similarity:        match.Similarity,
+		SharedGenres:      match.SharedGenres,
+		GenreContribution: match.GenreContribution,
+	}
+	for i, artistID := range match.SharedArtistIDs {
+		artist, ok := artists[artistID]
+		if !ok {
+			continue
+		}
+		shared := &spotifyv1.SharedArtist{
+			Artist:      artistInfo(artist),
+			MyRank:      int32(myRanks[artistID]),
+			PartnerRank: int32(partnerRanks[artistID]),
+		}
+		if i < len(match.ArtistContributions) {
+			shared.Contribution = match.ArtistContributions[i]
+		}
+		resp.SharedArtists = append(resp.SharedArtists, shared)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// getMyCarryover reports whether an unmatched user was carried over to the next round
+func (s *SpotifyServer) getMyCarryover(ctx context.Context, resp *spotifyv1.GetMyMatchResponse, userID int,
+) (*connect.Response[spotifyv1.GetMyMatchResponse], error) {
+	toRoundID, err := s.dbClient.GetUserCarryover(ctx, int(resp.RoundId), userID)
+	if errors.Is(err, db.ErrNotCarriedOver) {
+		resp.Status = spotifyv1.MatchStatus_MATCH_STATUS_UNMATCHED
+		return connect.NewResponse(resp), nil
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp.Status = spotifyv1.MatchStatus_MATCH_STATUS_CARRIED_OVER
+	resp.CarriedOverToRoundId = int32(toRoundID)
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,218 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+	spotifyv1 "github.com/sukhmai/spotify-match/gen/spotify/v1"
+	"github.com/sukhmai/spotify-match/pkg/db"
+)
+
+// maxSelectedArtists is how many artists users can pick by hand
+const maxSelectedArtists = 10
+
+// maxArtists returns how many artists a user who has the given number of
+// artists can keep. Users who connected Spotify may have more top artists than
+// can be picked by hand, so their limit is however many they have. Only the
+// count is limited; any of the artists can be swapped for others.
+func maxArtists(current int) int {
+	return max(maxSelectedArtists, current)
+}
+
+// GetMyProfile returns the profile of the user identified by the user token,
+// with their artists for the latest round they joined
+func (s *SpotifyServer) GetMyProfile(ctx context.Context,
+	req *connect.Request[spotifyv1.GetMyProfileRequest],
+) (*connect.Response[spotifyv1.GetMyProfileResponse], error) {
+	userID, round, err := s.userRound(ctx, req.Msg.UserToken, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.profile(ctx, userID, round)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&spotifyv1.GetMyProfileResponse{Profile: profile}), nil
+}
+
+// UpdateProfile changes the user's name, phone number, notification channels
+// and, in rounds with sides, their side. Their email identifies them and can't
+// be changed.
+func (s *SpotifyServer) UpdateProfile(ctx context.Context,
+	req *connect.Request[spotifyv1.UpdateProfileRequest],
+) (*connect.Response[spotifyv1.UpdateProfileResponse], error) {
+	if req.Msg.FirstName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("first_name is required"))
+	}
+	if req.Msg.LastName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("last_name is required"))
+	}
+
+	userID, round, err := s.editableRound(ctx, req.Msg.UserToken)
+	if err != nil {
+		return nil, err
+	}
+	channels, err := signupChannels(req.Msg.NotificationChannels, req.Msg.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+	side, err := signupSide(round, req.Msg.Side)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.dbClient.UpdateUser(ctx, db.User{
+		ID:                   userID,
+		FirstName:            req.Msg.FirstName,
+		LastName:             req.Msg.LastName,
+		PhoneNumber:          req.Msg.PhoneNumber,
+		NotificationChannels: channels,
+	})
+	if errors.Is(err, db.ErrUserNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if len(round.Settings.Sides) > 0 {
+		err = s.dbClient.UpdateUserSide(ctx, round.ID, userID, side)
+		if errors.Is(err, db.ErrNotEnrolled) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	profile, err := s.profile(ctx, userID, round)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&spotifyv1.UpdateProfileResponse{Profile: profile}), nil
+}
+
+// UpdateMyArtists replaces the user's artists for their round with the given
+// list, so reordering, adding and removing artists all send the whole list
+func (s *SpotifyServer) UpdateMyArtists(ctx context.Context,
+	req *connect.Request[spotifyv1.UpdateMyArtistsRequest],
+) (*connect.Response[spotifyv1.UpdateMyArtistsResponse], error) {
+	if len(req.Msg.ArtistIds) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("artist_ids is required"))
+	}
+	seen := make(map[string]bool, len(req.Msg.ArtistIds))
+	for _, artistID := range req.Msg.ArtistIds {
+		if seen[artistID] {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("artist %s is listed twice", artistID))
+		}
+		seen[artistID] = true
+	}
+
+	userID, round, err := s.editableRound(ctx, req.Msg.UserToken)
+	if err != nil {
+		return nil, err
+	}
+
+	ranks, err := s.dbClient.GetUserArtistRanks(ctx, round.ID, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if limit := maxArtists(len(ranks)); len(req.Msg.ArtistIds) > limit {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("you can select up to %d artists", limit))
+	}
+
+	_, err = s.dbClient.ReplaceUserArtists(ctx, round.ID, userID, req.Msg.ArtistIds)
+	if errors.Is(err, db.ErrUnknownArtist) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if errors.Is(err, db.ErrRoundNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if errors.Is(err, db.ErrRoundClosed) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			errors.New("your round has closed, your profile can no longer be changed"))
+	}
+	if errors.Is(err, db.ErrNotEnrolled) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// The round stays open while the artists are replaced, so the profile is
+	// still editable
+	profile, err := s.profile(ctx, userID, round)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&spotifyv1.UpdateMyArtistsResponse{Profile: profile}), nil
+}
+
+// editableRound identifies the user from their token and returns the latest
+// round they joined, failing if it has closed
+func (s *Server) editableRound(ctx context.Context, userToken string) (int, db.Round, error) {
+	userID, round, err := s.userRound(ctx, userToken, 0)
+	if err != nil {
+		return 0, db.Round{}, err
+	}
+	if !editable(round) {
+		return 0, db.Round{}, connect.NewError(connect.CodeFailedPrecondition,
+			errors.New("your round has closed, your profile can no longer be changed"))
+	}
+	return userID, round, nil
+}
+
+// editable reports whether users can still change their signup for the round
+func editable(round db.Round) bool {
+	return round.Status == db.RoundStatusOpen && round.MatchedAt == nil
+}
+
+// profile builds the profile of the user with their artists for the round
+func (s *Server) profile(ctx context.Context, userID int, round db.Round) (*spotifyv1.Profile, error) {
+	user, err := s.dbClient.GetUser(ctx, userID)
+	if errors.Is(err, db.ErrUserNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	ranks, err := s.dbClient.GetUserArtistRanks(ctx, round.ID, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	artistIDs := make([]int, 0, len(ranks))
+	for artistID := range ranks {
+		artistIDs = append(artistIDs, artistID)
+	}
+	slices.SortFunc(artistIDs, func(a, b int) int { return ranks[a] - ranks[b] })
+	artists, err := s.artistInfos(ctx, artistIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get artists: %w", err))
+	}
+	side, err := s.dbClient.GetUserSide(ctx, round.ID, userID)
+	if errors.Is(err, db.ErrNotEnrolled) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return &spotifyv1.Profile{
+		FirstName:            user.FirstName,
+		LastName:             user.LastName,
+		Email:                user.Email,
+		PhoneNumber:          user.PhoneNumber,
+		NotificationChannels: user.NotificationChannels,
+		RoundId:              int32(round.ID),
+		Artists:              artists,
+		Editable:             editable(round),
+		MaxArtists:           int32(maxArtists(len(ranks))),
+		Side:                 side,
+		Sides:                round.Settings.Sides,
+	}, nil
+}
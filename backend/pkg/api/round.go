@@ -0,0 +1,418 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	spotifyv1 "github.com/sukhmai/spotify-match/gen/spotify/v1"
+	"github.com/sukhmai/spotify-match/gen/spotify/v1/spotifyv1connect"
+	"github.com/sukhmai/spotify-match/pkg/db"
+	"github.com/sukhmai/spotify-match/pkg/matching"
+	"github.com/sukhmai/spotify-match/pkg/notify"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Default number of users allowed in a round when no capacity is given
+const DefaultRoundCapacity = 500
+
+type RoundServer struct {
+	spotifyv1connect.UnimplementedRoundServiceHandler
+	*Server
+}
+
+func NewRoundServer(s *Server) *RoundServer {
+	return &RoundServer{
+		Server: s,
+	}
+}
+
+// requireAdmin checks that the request carries the admin API key as a bearer token
+func (s *Server) requireAdmin(header http.Header) error {
+	if s.adminAPIKey == "" {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("admin API is disabled"))
+	}
+	token, ok := strings.CutPrefix(header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.adminAPIKey)) != 1 {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("invalid admin API key"))
+	}
+	return nil
+}
+
+// roundError converts round-related database errors to connect errors
+func roundError(err error) error {
+	switch {
+	case errors.Is(err, db.ErrRoundNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, db.ErrNoOpenRound),
+		errors.Is(err, db.ErrRoundAlreadyOpen),
+		errors.Is(err, db.ErrInvalidRoundTransition),
+		errors.Is(err, db.ErrRoundClosed),
+		errors.Is(err, db.ErrRoundSettingsLocked),
+		errors.Is(err, db.ErrSidesLocked):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// roundInfo converts a database round to its response format
+func (s *Server) roundInfo(ctx context.Context, round db.Round) (*spotifyv1.Round, error) {
+	userCount, err := s.dbClient.GetRoundUserCount(ctx, round.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &spotifyv1.Round{
+		RoundId:   int32(round.ID),
+		Name:      round.Name,
+		Capacity:  int32(round.Capacity),
+		UserCount: int32(userCount),
+		CreatedAt: timestamppb.New(round.CreatedAt),
+		Settings: &spotifyv1.RoundSettings{
+			Scorer:      round.Settings.Scorer,
+			GenreWeight: &round.Settings.GenreWeight,
+			Mode:        round.Settings.Mode,
+			Objective:   round.Settings.Objective,
+			GroupSize:   int32(round.Settings.GroupSize),
+			MinScore:    int32(round.Settings.MinScore),
+			Candidates:  int32(round.Settings.Candidates),
+			Sides:       round.Settings.Sides,
+		},
+	}
+
+	switch round.Status {
+	case db.RoundStatusDraft:
+		info.Status = spotifyv1.RoundStatus_ROUND_STATUS_DRAFT
+	case db.RoundStatusOpen:
+		info.Status = spotifyv1.RoundStatus_ROUND_STATUS_OPEN
+	case db.RoundStatusClosed:
+		info.Status = spotifyv1.RoundStatus_ROUND_STATUS_CLOSED
+	}
+
+	if round.OpenedAt != nil {
+		info.OpenedAt = timestamppb.New(*round.OpenedAt)
+	}
+	if round.ClosedAt != nil {
+		info.ClosedAt = timestamppb.New(*round.ClosedAt)
+	}
+	if round.MatchedAt != nil {
+		info.MatchedAt = timestamppb.New(*round.MatchedAt)
+	}
+
+	return info, nil
+}
+
+// roundSettings validates the requested settings and fills in defaults
+func roundSettings(settings *spotifyv1.RoundSettings) (db.RoundSettings, error) {
+	result := db.RoundSettings{
+		Scorer:      settings.GetScorer(),
+		GenreWeight: matching.DefaultGenreWeight,
+		Mode:        settings.GetMode(),
+		Objective:   settings.GetObjective(),
+		GroupSize:   int(settings.GetGroupSize()),
+		MinScore:    int(settings.GetMinScore()),
+		Candidates:  int(settings.GetCandidates()),
+		Sides:       settings.GetSides(),
+	}
+	if settings != nil && settings.GenreWeight != nil {
+		result.GenreWeight = settings.GetGenreWeight()
+	}
+
+	if result.Scorer == "" {
+		result.Scorer = matching.DefaultScorer
+	}
+	if !matching.IsScorer(result.Scorer) {
+		return db.RoundSettings{}, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("unknown scorer %q, must be one of %v", result.Scorer, matching.ScorerNames()))
+	}
+	if result.GenreWeight < 0 || result.GenreWeight > 1 {
+		return db.RoundSettings{}, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("genre_weight must be between 0 and 1"))
+	}
+
+	if result.Mode == "" {
+		result.Mode = matching.ModePairs
+	}
+	if !matching.IsMode(result.Mode) {
+		return db.RoundSettings{}, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("unknown mode %q, must be one of %v", result.Mode, matching.ModeNames()))
+	}
+	if result.Objective == "" {
+		result.Objective = matching.ObjectiveTotal
+	}
+	if !matching.IsObjective(result.Objective) {
+		return db.RoundSettings{}, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("unknown objective %q, must be one of %v", result.Objective, matching.ObjectiveNames()))
+	}
+	if result.GroupSize == 0 {
+		result.GroupSize = matching.DefaultGroupSize
+	}
+	if result.GroupSize < matching.MinGroupSize || result.GroupSize > matching.MaxGroupSize {
+		return db.RoundSettings{}, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("group_size must be between %d and %d", matching.MinGroupSize, matching.MaxGroupSize))
+	}
+	if result.MinScore < 0 || result.MinScore > 100 {
+		return db.RoundSettings{}, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("min_score must be between 0 and 100"))
+	}
+	if result.Candidates < 0 {
+		return db.RoundSettings{}, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("candidates must not be negative"))
+	}
+	if len(result.Sides) > 0 {
+		if len(result.Sides) != 2 || result.Sides[0] == "" || result.Sides[1] == "" || result.Sides[0] == result.Sides[1] {
+			return db.RoundSettings{}, connect.NewError(connect.CodeInvalidArgument,
+				errors.New("sides must be two different names"))
+		}
+	} else if result.Mode == matching.ModeBipartite {
+		return db.RoundSettings{}, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("bipartite mode needs two sides"))
+	}
+
+	return result, nil
+}
+
+var (
+	errSignupsClosed = errors.New("signups are closed, please wait for the next round")
+	errRoundFull     = errors.New("maximum number of users reached for this round, please wait for the next round")
+)
+
+// openRound returns the round accepting signups, failing if there is none.
+// Its capacity is enforced when users are enrolled.
+func (s *Server) openRound(ctx context.Context) (db.Round, error) {
+	round, err := s.dbClient.GetOpenRound(ctx)
+	if errors.Is(err, db.ErrNoOpenRound) {
+		return db.Round{}, connect.NewError(connect.CodeFailedPrecondition, errSignupsClosed)
+	}
+	if err != nil {
+		return db.Round{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get open round: %w", err))
+	}
+	return round, nil
+}
+
+// checkRoundCapacity fails if the round is full, unless the user with the
+// given email is already part of it. Enrolling the user checks again, so this
+// only spares users a signup that can't go through.
+func (s *Server) checkRoundCapacity(ctx context.Context, round db.Round, email string) error {
+	userCount, err := s.dbClient.GetRoundUserCount(ctx, round.ID)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get user count: %w", err))
+	}
+	if userCount < round.Capacity {
+		return nil
+	}
+	enrolled, err := s.dbClient.IsEmailEnrolled(ctx, round.ID, email)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if !enrolled {
+		return connect.NewError(connect.CodeResourceExhausted, errRoundFull)
+	}
+	return nil
+}
+
+// signupError converts an error from saving a signup, which fails if the
+// round closed or filled up in the meantime
+func signupError(err error) error {
+	switch {
+	case errors.Is(err, db.ErrRoundFull):
+		return connect.NewError(connect.CodeResourceExhausted, errRoundFull)
+	case errors.Is(err, db.ErrRoundClosed):
+		return connect.NewError(connect.CodeFailedPrecondition, errSignupsClosed)
+	default:
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save user and artists: %w", err))
+	}
+}
+
+// signupSide checks the side a user chose against the sides of the round they
+// are signing up for. Rounds without sides ignore it.
+func signupSide(round db.Round, side string) (string, error) {
+	if len(round.Settings.Sides) == 0 {
+		return "", nil
+	}
+	for _, s := range round.Settings.Sides {
+		if s == side {
+			return side, nil
+		}
+	}
+	return "", connect.NewError(connect.CodeInvalidArgument,
+		fmt.Errorf("side must be one of %v", round.Settings.Sides))
+}
+
+// signupChannels checks the notification channels a user chose, defaulting to
+// email. Texts need a phone number that can be sent to.
+func signupChannels(channels []string, phoneNumber string) ([]string, error) {
+	if len(channels) == 0 {
+		return []string{notify.ChannelEmail}, nil
+	}
+	var valid []string
+	for _, channel := range channels {
+		if !slices.Contains(notify.ChannelNames(), channel) {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("notification channels must be among %v", notify.ChannelNames()))
+		}
+		if channel == notify.ChannelSMS {
+			if _, err := notify.PhoneNumber(phoneNumber); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument,
+					errors.New("a valid phone number is required to be notified by text"))
+			}
+		}
+		if !slices.Contains(valid, channel) {
+			valid = append(valid, channel)
+		}
+	}
+	return valid, nil
+}
+
+// CreateRound creates a new draft round
+func (s *RoundServer) CreateRound(ctx context.Context,
+	req *connect.Request[spotifyv1.CreateRoundRequest],
+) (*connect.Response[spotifyv1.CreateRoundResponse], error) {
+	if err := s.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+
+	name := req.Msg.Name
+	if name == "" {
+		name = time.Now().Format("January 2006")
+	}
+
+	capacity := int(req.Msg.Capacity)
+	if capacity < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("capacity must not be negative"))
+	}
+	if capacity == 0 {
+		capacity = DefaultRoundCapacity
+	}
+
+	settings, err := roundSettings(req.Msg.Settings)
+	if err != nil {
+		return nil, err
+	}
+
+	round, err := s.dbClient.CreateRound(ctx, name, capacity, settings)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	info, err := s.roundInfo(ctx, round)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&spotifyv1.CreateRoundResponse{Round: info}), nil
+}
+
+// OpenRound starts accepting signups for a draft round
+func (s *RoundServer) OpenRound(ctx context.Context,
+	req *connect.Request[spotifyv1.OpenRoundRequest],
+) (*connect.Response[spotifyv1.OpenRoundResponse], error) {
+	if err := s.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+
+	round, err := s.dbClient.OpenRound(ctx, int(req.Msg.RoundId))
+	if err != nil {
+		return nil, roundError(err)
+	}
+
+	info, err := s.roundInfo(ctx, round)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&spotifyv1.OpenRoundResponse{Round: info}), nil
+}
+
+// CloseRound stops accepting signups for an open round
+func (s *RoundServer) CloseRound(ctx context.Context,
+	req *connect.Request[spotifyv1.CloseRoundRequest],
+) (*connect.Response[spotifyv1.CloseRoundResponse], error) {
+	if err := s.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+
+	round, err := s.dbClient.CloseRound(ctx, int(req.Msg.RoundId))
+	if err != nil {
+		return nil, roundError(err)
+	}
+
+	info, err := s.roundInfo(ctx, round)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&spotifyv1.CloseRoundResponse{Round: info}), nil
+}
+
+// GetRound retrieves a round along with its current user count
+func (s *RoundServer) GetRound(ctx context.Context,
+	req *connect.Request[spotifyv1.GetRoundRequest],
+) (*connect.Response[spotifyv1.GetRoundResponse], error) {
+	if err := s.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+
+	round, err := s.dbClient.GetRound(ctx, int(req.Msg.RoundId))
+	if err != nil {
+		return nil, roundError(err)
+	}
+
+	info, err := s.roundInfo(ctx, round)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&spotifyv1.GetRoundResponse{Round: info}), nil
+}
+
+// ListRounds retrieves all rounds, newest first
+func (s *RoundServer) ListRounds(ctx context.Context,
+	req *connect.Request[spotifyv1.ListRoundsRequest],
+) (*connect.Response[spotifyv1.ListRoundsResponse], error) {
+	if err := s.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+
+	rounds, err := s.dbClient.ListRounds(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	infos := make([]*spotifyv1.Round, len(rounds))
+	for i, round := range rounds {
+		infos[i], err = s.roundInfo(ctx, round)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	return connect.NewResponse(&spotifyv1.ListRoundsResponse{Rounds: infos}), nil
+}
+
+// UpdateRoundSettings changes how a round will be matched
+func (s *RoundServer) UpdateRoundSettings(ctx context.Context,
+	req *connect.Request[spotifyv1.UpdateRoundSettingsRequest],
+) (*connect.Response[spotifyv1.UpdateRoundSettingsResponse], error) {
+	if err := s.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+
+	settings, err := roundSettings(req.Msg.Settings)
+	if err != nil {
+		return nil, err
+	}
+
+	round, err := s.dbClient.UpdateRoundSettings(ctx, int(req.Msg.RoundId), settings)
+	if err != nil {
+		return nil, roundError(err)
+	}
+
+	info, err := s.roundInfo(ctx, round)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&spotifyv1.UpdateRoundSettingsResponse{Round: info}), nil
+}
@@ -3,54 +3,45 @@ package api
 import (
 	"context"
 	"errors"
-	"fmt"
 	"os"
+	"strings"
 
 	"github.com/sukhmai/spotify-match/pkg/db"
+	"github.com/sukhmai/spotify-match/pkg/notify"
+	"github.com/sukhmai/spotify-match/pkg/token"
 	"go.uber.org/zap"
 )
 
 type Server struct {
-	dbClient *db.DBClient
-	logger   *zap.SugaredLogger
+	dbClient    *db.DBClient
+	logger      *zap.SugaredLogger
+	tokens      *token.Signer
+	adminAPIKey string
+	// contactNotifiers tell both users of a match once they both accepted,
+	// by email with the contact details and by text with a link to them.
+	// Without any the details are only shown in the app.
+	contactNotifiers []*notify.Notifier
+	contactLinks     *notify.Links
+	// verifier emails manual signups a link to confirm their email, which
+	// verifyURL points to. Without it manual signups join the round right away.
+	verifier  *notify.Notifier
+	verifyURL string
 }
 
-const defaultDbUsername = "spotifyuser"
-const defaultDbName = "spotify"
-const defaultServerAddr = "localhost:5432"
-
-func NewDefaultServer() (*Server, error) {
-	// Get database address from environment variable or use default
-	dbAddr := os.Getenv("DB_HOST")
-	if dbAddr == "" {
-		dbAddr = defaultServerAddr
-	}
-	return NewServer(dbAddr)
-}
-
-func NewServer(dbAddr string) (*Server, error) {
+// NewServer configures the server from environment variables, connecting to
+// the database as described in db.NewClientFromEnv
+func NewServer() (*Server, error) {
 	prod, err := zap.NewProduction()
 	if err != nil {
 		return nil, err
 	}
-	// Get database credentials from environment variables with defaults
-	username := os.Getenv("DB_USERNAME")
-	if username == "" {
-		username = defaultDbUsername
-	}
-
-	dbName := os.Getenv("DB_NAME")
-	if dbName == "" {
-		dbName = defaultDbName
-	}
 
-	password := os.Getenv("DB_PASSWORD")
-	if password == "" {
-		return nil, errors.New("DB_PASSWORD environment variable not set")
+	tokenSecret := os.Getenv("TOKEN_SECRET")
+	if tokenSecret == "" {
+		return nil, errors.New("TOKEN_SECRET environment variable not set")
 	}
 
-	connString := fmt.Sprintf("postgres://%s:%s@%s/%s", username, password, dbAddr, dbName)
-	dbClient, err := db.NewClient(connString)
+	dbClient, err := db.NewClientFromEnv()
 	if err != nil {
 		return nil, err
 	}
@@ -58,9 +49,66 @@ func NewServer(dbAddr string) (*Server, error) {
 	if err != nil {
 		return nil, err
 	}
+	tokens := token.NewSigner([]byte(tokenSecret))
+
+	// Contact emails and texts are only enqueued when a sender is configured.
+	// Emails link to the feedback page and texts to the match page, so texts
+	// also need the app URL.
+	var contactNotifiers []*notify.Notifier
+	if from := os.Getenv("NOTIFY_FROM"); from != "" {
+		contactNotifiers = append(contactNotifiers, &notify.Notifier{
+			Template: notify.DefaultContactTemplate(),
+			Name:     notify.TemplateContact,
+			From:     from,
+			Subject:  "It's a match, here's how to reach them!",
+		})
+	}
+	contactLinks := &notify.Links{Signer: tokens}
+	if appURL := strings.TrimSuffix(os.Getenv("APP_URL"), "/"); appURL != "" {
+		contactLinks.ResponseURL = appURL + "/match"
+		contactLinks.FeedbackURL = appURL + "/feedback"
+		if from := os.Getenv("SMS_FROM"); from != "" {
+			contactNotifiers = append(contactNotifiers, &notify.Notifier{
+				Template: notify.DefaultContactSMSTemplate(),
+				Name:     notify.TemplateContact,
+				Channel:  notify.ChannelSMS,
+				From:     from,
+			})
+		}
+	}
+
+	// Manual signups only count toward the round once their email is
+	// verified, when there is a mailer to send the verification link with
+	var verifier *notify.Notifier
+	var verifyURL string
+	if mailer := os.Getenv("MAILER"); mailer != "" {
+		from := os.Getenv("NOTIFY_FROM")
+		appURL := strings.TrimSuffix(os.Getenv("APP_URL"), "/")
+		if from == "" || appURL == "" {
+			return nil, errors.New("NOTIFY_FROM and APP_URL environment variables must be set to verify emails")
+		}
+		m, err := notify.NewMailerFromEnv(mailer)
+		if err != nil {
+			return nil, err
+		}
+		verifier = &notify.Notifier{
+			Mailer:   m,
+			Template: notify.DefaultVerificationTemplate(),
+			From:     from,
+			Subject:  "Confirm your email for Path Match",
+		}
+		verifyURL = appURL + "/verify"
+	}
+
 	return &Server{
-		dbClient: dbClient,
-		logger:   logger,
+		dbClient:         dbClient,
+		logger:           logger,
+		tokens:           tokens,
+		adminAPIKey:      os.Getenv("ADMIN_API_KEY"),
+		contactNotifiers: contactNotifiers,
+		contactLinks:     contactLinks,
+		verifier:         verifier,
+		verifyURL:        verifyURL,
 	}, nil
 }
 
@@ -0,0 +1,142 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	spotifyv1 "github.com/sukhmai/spotify-match/gen/spotify/v1"
+	"github.com/sukhmai/spotify-match/pkg/db"
+	"github.com/sukhmai/spotify-match/pkg/matching"
+)
+
+// SimulateMatching runs matching for a round without saving the results, so
+// that settings can be tuned before matches are sent out
+func (s *RoundServer) SimulateMatching(ctx context.Context,
+	req *connect.Request[spotifyv1.SimulateMatchingRequest],
+) (*connect.Response[spotifyv1.SimulateMatchingResponse], error) {
+	if err := s.requireAdmin(req.Header()); err != nil {
+		return nil, err
+	}
+
+	round, err := s.simulationRound(ctx, int(req.Msg.RoundId))
+	if err != nil {
+		return nil, roundError(err)
+	}
+
+	settings := round.Settings
+	if req.Msg.Settings != nil {
+		settings, err = roundSettings(req.Msg.Settings)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sample := int(req.Msg.SampleSize)
+	if sample < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("sample_size must not be negative"))
+	}
+	if sample == 0 {
+		sample = matching.DefaultReportSample
+	}
+
+	cohort, err := matching.LoadCohort(ctx, s.dbClient, round.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load users: %w", err))
+	}
+
+	opts, err := matching.OptionsForRound(cohort, settings)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if !req.Msg.AllowRepeats {
+		pastPairs, err := s.dbClient.GetPastPairs(ctx, round.ID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		opts.History = matching.NewHistory(pastPairs)
+	}
+
+	report := matching.NewReport(cohort, matching.Match(cohort, opts), sample)
+	if opts.Mode == matching.ModePairs || opts.Mode == matching.ModeBipartite {
+		report.Objectives = matching.CompareObjectives(cohort, opts)
+	}
+
+	resp := &spotifyv1.SimulateMatchingResponse{
+		RoundId:              int32(round.ID),
+		Scorer:               opts.Scorer.Name(),
+		UserCount:            int32(report.Users),
+		MatchCount:           int32(report.Matches),
+		MeanMatchScore:       report.MeanScore,
+		UnmatchedCount:       int32(report.Unmatched),
+		RelaxedCount:         int32(report.Relaxed),
+		NoSharedArtistsCount: int32(report.NoSharedArtists),
+		ScoredPairs:          int64(report.Stats.Edges),
+		ElapsedSeconds:       report.Stats.Elapsed.Seconds(),
+		AllocatedBytes:       int64(report.Stats.AllocatedBytes),
+		MeanPartnerRank:      report.Fairness.MeanPartnerRank,
+		WorstPartnerRank:     int32(report.Fairness.WorstPartnerRank),
+		BlockingPairs:        int32(report.Fairness.BlockingPairs),
+		Unstable:             report.Unstable,
+		DiscoveryCount:       int32(report.Discovery),
+		UnsidedCount:         int32(report.Unsided),
+	}
+	for _, count := range report.ScoreHistogram {
+		resp.ScoreHistogram = append(resp.ScoreHistogram, int32(count))
+	}
+	for _, o := range report.Objectives {
+		resp.Objectives = append(resp.Objectives, &spotifyv1.ObjectiveScores{
+			Objective:   o.Objective,
+			MatchCount:  int32(o.Matches),
+			MinScore:    int32(o.MinScore),
+			MedianScore: o.MedianScore,
+			MeanScore:   o.MeanScore,
+		})
+	}
+	for _, side := range report.Sides {
+		resp.Sides = append(resp.Sides, &spotifyv1.SideCount{
+			Side:         side.Side,
+			UserCount:    int32(side.Members),
+			MatchedCount: int32(side.Matched),
+		})
+	}
+	for _, e := range report.Top {
+		resp.TopMatches = append(resp.TopMatches, simulatedMatch(cohort, e))
+	}
+	for _, e := range report.Bottom {
+		resp.BottomMatches = append(resp.BottomMatches, simulatedMatch(cohort, e))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// simulationRound returns the requested round, defaulting to the open round
+// and then to the most recently opened one
+func (s *RoundServer) simulationRound(ctx context.Context, roundID int) (db.Round, error) {
+	if roundID != 0 {
+		return s.dbClient.GetRound(ctx, roundID)
+	}
+	round, err := s.dbClient.GetOpenRound(ctx)
+	if errors.Is(err, db.ErrNoOpenRound) {
+		return s.dbClient.GetLatestRound(ctx)
+	}
+	return round, err
+}
+
+// simulatedMatch converts a report entry to its response format
+func simulatedMatch(cohort *matching.Cohort, e matching.ReportEntry) *spotifyv1.SimulatedMatch {
+	match := &spotifyv1.SimulatedMatch{
+		MatchScore: int32(e.MatchScore),
+		Similarity: e.Similarity,
+	}
+	for _, m := range e.Members {
+		match.Members = append(match.Members, &spotifyv1.MatchPartner{
+			FirstName: m.User.FirstName,
+			LastName:  m.User.LastName,
+		})
+	}
+	for _, artistID := range e.CommonArtists {
+		match.SharedArtists = append(match.SharedArtists, cohort.ArtistName(artistID))
+	}
+	return match
+}
@@ -8,17 +8,16 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"strings"
 
 	"connectrpc.com/connect"
 	spotifyv1 "github.com/sukhmai/spotify-match/gen/spotify/v1"
 	"github.com/sukhmai/spotify-match/gen/spotify/v1/spotifyv1connect"
 	"github.com/sukhmai/spotify-match/pkg/db"
 	"github.com/sukhmai/spotify-match/pkg/spotify"
+	"github.com/sukhmai/spotify-match/pkg/token"
 )
 
-// Maximum number of users allowed for the current round
-const MaxUsersPerRound = 500
-
 type SpotifyServer struct {
 	spotifyv1connect.UnimplementedSpotifyServiceHandler
 	*Server
@@ -61,25 +60,33 @@ func (s *SpotifyServer) SaveTopArtists(ctx context.Context,
 	// Get the database client
 	dbClient := s.dbClient
 
-	// Check if we've reached the maximum number of users
-	userCount, err := dbClient.GetUserCount(ctx)
+	// Make sure there is an open round; its capacity is checked as the user joins it
+	round, err := s.openRound(ctx)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get user count: %w", err))
+		return nil, err
 	}
-
-	// If we've reached the limit, return an error
-	if userCount >= MaxUsersPerRound {
-		return nil, connect.NewError(connect.CodeResourceExhausted,
-			errors.New("maximum number of users reached for this round, please wait for the next round"))
+	side, err := signupSide(round, req.Msg.Side)
+	if err != nil {
+		return nil, err
+	}
+	channels, err := signupChannels(req.Msg.NotificationChannels, req.Msg.Number)
+	if err != nil {
+		return nil, err
 	}
 
-	// Create user info struct with Spotify user ID
+	// Create user info struct with Spotify user ID. Spotify has verified the
+	// email of the account, so signing up with it links an existing account.
 	userInfo := db.UserInfo{
 		FirstName:     req.Msg.FirstName,
 		LastName:      req.Msg.LastName,
 		Email:         req.Msg.Email,
 		PhoneNumber:   req.Msg.Number,
 		SpotifyUserID: profile.ID,
+		Discovery:     req.Msg.Discovery,
+		Side:          side,
+		EmailVerified: profile.Email != "" && strings.EqualFold(profile.Email, req.Msg.Email),
+
+		NotificationChannels: channels,
 	}
 
 	// Convert Spotify artists to database artists with all fields
@@ -110,9 +117,16 @@ func (s *SpotifyServer) SaveTopArtists(ctx context.Context,
 	}
 
 	// Save user and artists to the database
-	userID, newArtists, err := dbClient.SaveUserTopArtists(ctx, userInfo, dbArtists)
+	userID, newArtists, err := dbClient.SaveUserTopArtists(ctx, round.ID, userInfo, dbArtists)
+	if errors.Is(err, db.ErrEmailTaken) {
+		return nil, connect.NewError(connect.CodeAlreadyExists,
+			errors.New("another account already uses this email, sign up with the email of your Spotify account to link them"))
+	}
+	if errors.Is(err, db.ErrSpotifyLinked) {
+		return nil, connect.NewError(connect.CodeAlreadyExists, err)
+	}
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save user and artists: %w", err))
+		return nil, signupError(err)
 	}
 
 	// Convert the new artists to response format with additional information
@@ -158,10 +172,16 @@ func (s *SpotifyServer) SaveTopArtists(ctx context.Context,
 		uniqueArtists[i] = artistInfo
 	}
 
+	userToken, err := s.issueUserToken(userID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Return the response with user ID and unique artists
 	return connect.NewResponse(&spotifyv1.SaveTopArtistsResponse{
 		UserId:        userID,
 		UniqueArtists: uniqueArtists,
+		UserToken:     userToken,
 	}), nil
 }
 
@@ -220,16 +240,29 @@ func (s *SpotifyServer) ExchangeToken(ctx context.Context,
 	}), nil
 }
 
+// GetUserCount reports how many users have joined the open round and its capacity
+// If no round is open, an empty count is returned
 func (s *SpotifyServer) GetUserCount(ctx context.Context,
 	req *connect.Request[spotifyv1.GetUserCountRequest],
 ) (*connect.Response[spotifyv1.GetUserCountResponse], error) {
-	userCount, err := s.dbClient.GetUserCount(ctx)
+	round, err := s.dbClient.GetOpenRound(ctx)
+	if errors.Is(err, db.ErrNoOpenRound) {
+		return connect.NewResponse(&spotifyv1.GetUserCountResponse{}), nil
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	userCount, err := s.dbClient.GetRoundUserCount(ctx, round.ID)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	return connect.NewResponse(&spotifyv1.GetUserCountResponse{
-		Count:    int32(userCount),
-		MaxUsers: MaxUsersPerRound,
+		Count:     int32(userCount),
+		MaxUsers:  int32(round.Capacity),
+		RoundId:   int32(round.ID),
+		RoundName: round.Name,
+		Sides:     round.Settings.Sides,
 	}), nil
 }
 
@@ -448,17 +481,23 @@ func (s *SpotifyServer) SaveUserSelectedArtists(ctx context.Context,
 	if len(req.Msg.ArtistIds) == 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("artist_ids is required"))
 	}
+	if len(req.Msg.ArtistIds) > maxSelectedArtists {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("you can select up to %d artists", maxSelectedArtists))
+	}
 
-	// Check if we've reached the maximum number of users
-	userCount, err := s.dbClient.GetUserCount(ctx)
+	// Make sure there is an open round; its capacity is checked as the user joins it
+	round, err := s.openRound(ctx)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get user count: %w", err))
+		return nil, err
 	}
-
-	// If we've reached the limit, return an error
-	if userCount >= MaxUsersPerRound {
-		return nil, connect.NewError(connect.CodeResourceExhausted,
-			errors.New("maximum number of users reached for this round, please wait for the next round"))
+	side, err := signupSide(round, req.Msg.Side)
+	if err != nil {
+		return nil, err
+	}
+	channels, err := signupChannels(req.Msg.NotificationChannels, req.Msg.Number)
+	if err != nil {
+		return nil, err
 	}
 
 	// Create user info struct (without Spotify user ID since we don't have it)
@@ -467,12 +506,43 @@ func (s *SpotifyServer) SaveUserSelectedArtists(ctx context.Context,
 		LastName:    req.Msg.LastName,
 		Email:       req.Msg.Email,
 		PhoneNumber: req.Msg.Number,
+		Discovery:   req.Msg.Discovery,
+		Side:        side,
+
+		NotificationChannels: channels,
+	}
+
+	// Hold the signup until the user confirms their email when we can send them a link
+	if s.verifier != nil {
+		if err := s.checkRoundCapacity(ctx, round, userInfo.Email); err != nil {
+			return nil, err
+		}
+		signup, err := s.dbClient.CreatePendingSignup(ctx, round.ID, userInfo, req.Msg.ArtistIds, token.SignupTTL)
+		if errors.Is(err, db.ErrUnknownArtist) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save pending signup: %w", err))
+		}
+		if err := s.sendVerification(ctx, signup); err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&spotifyv1.SaveUserSelectedArtistsResponse{
+			VerificationPending: true,
+		}), nil
 	}
 
 	// Save the user and their selected artists
-	userID, artists, err := s.dbClient.SaveUserSelectedArtists(ctx, userInfo, req.Msg.ArtistIds)
+	userID, artists, err := s.dbClient.SaveUserSelectedArtists(ctx, round.ID, userInfo, req.Msg.ArtistIds)
+	if errors.Is(err, db.ErrEmailTaken) {
+		return nil, connect.NewError(connect.CodeAlreadyExists,
+			errors.New("this email has already signed up, connect Spotify with the same email to update your artists"))
+	}
+	if errors.Is(err, db.ErrUnknownArtist) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save user and artists: %w", err))
+		return nil, signupError(err)
 	}
 
 	// Convert to response format
@@ -511,9 +581,15 @@ func (s *SpotifyServer) SaveUserSelectedArtists(ctx context.Context,
 		uniqueArtists[i] = artistInfo
 	}
 
+	userToken, err := s.issueUserToken(userID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Return the response
 	return connect.NewResponse(&spotifyv1.SaveUserSelectedArtistsResponse{
 		UserId:        userID,
 		UniqueArtists: uniqueArtists,
+		UserToken:     userToken,
 	}), nil
 }
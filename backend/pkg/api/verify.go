@@ -0,0 +1,131 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"connectrpc.com/connect"
+	spotifyv1 "github.com/sukhmai/spotify-match/gen/spotify/v1"
+	"github.com/sukhmai/spotify-match/pkg/db"
+	"github.com/sukhmai/spotify-match/pkg/notify"
+	"github.com/sukhmai/spotify-match/pkg/token"
+)
+
+// resendInterval is how long a user has to wait before asking for another verification email
+const resendInterval = time.Minute
+
+// VerifyEmail confirms the email of a manual signup from the link in the
+// verification email. The user only joins the round once it is confirmed.
+func (s *SpotifyServer) VerifyEmail(ctx context.Context,
+	req *connect.Request[spotifyv1.VerifyEmailRequest],
+) (*connect.Response[spotifyv1.VerifyEmailResponse], error) {
+	signupID, err := s.tokens.VerifySignup(req.Msg.Token)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	signup, err := s.dbClient.GetPendingSignup(ctx, signupID)
+	if errors.Is(err, db.ErrSignupNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound,
+			errors.New("this link has expired or was already used, please sign up again"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// The round may have filled up or closed while the email was on its way,
+	// which confirming the signup checks
+	round, err := s.openRound(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if round.ID != signup.RoundID {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			errors.New("the round you signed up for has closed, please sign up for the current round"))
+	}
+
+	userID, artists, err := s.dbClient.ConfirmSignup(ctx, signupID)
+	if errors.Is(err, db.ErrSignupNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound,
+			errors.New("this link has expired or was already used, please sign up again"))
+	}
+	if err != nil {
+		return nil, signupError(err)
+	}
+
+	uniqueArtists := make([]*spotifyv1.ArtistInfo, len(artists))
+	for i, artist := range artists {
+		uniqueArtists[i] = artistInfo(artist)
+	}
+
+	userToken, err := s.issueUserToken(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&spotifyv1.VerifyEmailResponse{
+		UserId:        userID,
+		UniqueArtists: uniqueArtists,
+		UserToken:     userToken,
+	}), nil
+}
+
+// ResendVerification sends the verification email of the latest pending
+// signup for an email again, with a fresh link. It succeeds whether or not
+// there is such a signup, so that it can't be used to find out who signed up.
+func (s *SpotifyServer) ResendVerification(ctx context.Context,
+	req *connect.Request[spotifyv1.ResendVerificationRequest],
+) (*connect.Response[spotifyv1.ResendVerificationResponse], error) {
+	if s.verifier == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("email verification is not enabled"))
+	}
+	if req.Msg.Email == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("email is required"))
+	}
+
+	signup, err := s.dbClient.GetLatestPendingSignup(ctx, req.Msg.Email)
+	if errors.Is(err, db.ErrSignupNotFound) {
+		return connect.NewResponse(&spotifyv1.ResendVerificationResponse{}), nil
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// Throttled requests look the same as any other, so that quick repeats
+	// can't tell whether there is a signup either
+	if time.Since(signup.LastSentAt) < resendInterval {
+		return connect.NewResponse(&spotifyv1.ResendVerificationResponse{}), nil
+	}
+
+	signup, err = s.dbClient.RenewPendingSignup(ctx, signup.ID, token.SignupTTL)
+	if errors.Is(err, db.ErrSignupNotFound) {
+		return connect.NewResponse(&spotifyv1.ResendVerificationResponse{}), nil
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := s.sendVerification(ctx, signup); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&spotifyv1.ResendVerificationResponse{}), nil
+}
+
+// sendVerification emails a pending signup the link to confirm their email
+func (s *Server) sendVerification(ctx context.Context, signup db.PendingSignup) error {
+	signupToken, err := s.tokens.SignSignup(signup.ID)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to sign verification token: %w", err))
+	}
+	err = s.verifier.SendVerification(ctx, notify.VerificationEmail{
+		FirstName: signup.User.FirstName,
+		LastName:  signup.User.LastName,
+		Email:     signup.User.Email,
+		VerifyURL: s.verifyURL + "?token=" + url.QueryEscape(signupToken),
+		ExpiresAt: signup.ExpiresAt,
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeUnavailable, err)
+	}
+	return nil
+}
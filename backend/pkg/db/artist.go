@@ -4,10 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
-	_ "github.com/jackc/pgx/v5" // Import for side effects
+	"github.com/jackc/pgx/v5"
 )
 
 // Artist represents a Spotify artist with all available information
@@ -31,11 +33,17 @@ type UserInfo struct {
 	Email         string
 	PhoneNumber   string
 	SpotifyUserID string // Unique identifier from Spotify
+	Discovery     bool   // Opted in to a partner who can broaden their listening
+	Side          string // Side of a two-sided round the user belongs to
+	// NotificationChannels are the channels the user wants to be notified on
+	NotificationChannels []string
+	// EmailVerified is set when the user has confirmed that the email is theirs
+	EmailVerified bool
 }
 
-// SaveUserTopArtists saves a user and their top artists to the database
+// SaveUserTopArtists saves a user and their top artists to the database for the given round
 // Returns the user ID, newly added artists, and any error
-func (c *DBClient) SaveUserTopArtists(ctx context.Context, user UserInfo, artists []Artist) (string, []Artist, error) {
+func (c *DBClient) SaveUserTopArtists(ctx context.Context, roundID int, user UserInfo, artists []Artist) (string, []Artist, error) {
 	// Begin a transaction
 	tx, err := c.conn.Begin(ctx)
 	if err != nil {
@@ -48,20 +56,18 @@ func (c *DBClient) SaveUserTopArtists(ctx context.Context, user UserInfo, artist
 	var newArtists []Artist
 
 	// Insert or update the user
-	var userID string
-	err = tx.QueryRow(ctx,
-		`INSERT INTO users (first_name, last_name, email, phone_number, spotify_user_id)
-		VALUES ($1, $2, $3, $4, $5)
-		ON CONFLICT (spotify_user_id) DO UPDATE
-		SET first_name = $1, last_name = $2, email = $3, phone_number = $4
-		RETURNING user_id`,
-		user.FirstName, user.LastName, user.Email, user.PhoneNumber, user.SpotifyUserID).Scan(&userID)
+	userID, err := upsertUser(ctx, tx, user)
 	if err != nil {
-		return "", nil, fmt.Errorf("failed to insert/update user: %w", err)
+		return "", nil, err
+	}
+
+	// Enroll the user in the round
+	if err := enrollUser(ctx, tx, roundID, userID, user); err != nil {
+		return "", nil, err
 	}
 
-	// Delete existing user-artist relationships for this user
-	_, err = tx.Exec(ctx, "DELETE FROM user_artists WHERE user_id = $1", userID)
+	// Delete existing user-artist relationships for this user in this round
+	_, err = tx.Exec(ctx, "DELETE FROM user_artists WHERE round_id = $1 AND user_id = $2", roundID, userID)
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to delete existing user-artist relationships: %w", err)
 	}
@@ -142,9 +148,9 @@ func (c *DBClient) SaveUserTopArtists(ctx context.Context, user UserInfo, artist
 
 		// Link the user to the artist with the appropriate rank
 		_, err = tx.Exec(ctx,
-			`INSERT INTO user_artists (user_id, artist_id, rank)
-			VALUES ($1, $2, $3)`,
-			userID, artistID, i+1)
+			`INSERT INTO user_artists (round_id, user_id, artist_id, rank)
+			VALUES ($1, $2, $3, $4)`,
+			roundID, userID, artistID, i+1)
 		if err != nil {
 			return "", nil, fmt.Errorf("failed to link user to artist %s: %w", artist.Name, err)
 		}
@@ -158,9 +164,9 @@ func (c *DBClient) SaveUserTopArtists(ctx context.Context, user UserInfo, artist
 	return userID, newArtists, nil
 }
 
-// SaveUserSelectedArtists saves a user and their manually selected artists to the database
+// SaveUserSelectedArtists saves a user and their manually selected artists to the database for the given round
 // This is similar to SaveUserTopArtists but doesn't require a Spotify user ID
-func (c *DBClient) SaveUserSelectedArtists(ctx context.Context, user UserInfo, artistIDs []string) (string, []Artist, error) {
+func (c *DBClient) SaveUserSelectedArtists(ctx context.Context, roundID int, user UserInfo, artistIDs []string) (string, []Artist, error) {
 	// Begin a transaction
 	tx, err := c.conn.Begin(ctx)
 	if err != nil {
@@ -169,18 +175,101 @@ func (c *DBClient) SaveUserSelectedArtists(ctx context.Context, user UserInfo, a
 	// Ensure the transaction is rolled back if an error occurs
 	defer tx.Rollback(ctx)
 
+	userID, returnArtists, err := saveUserSelectedArtists(ctx, tx, roundID, user, artistIDs)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(ctx); err != nil {
+		return "", nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return userID, returnArtists, nil
+}
+
+// saveUserSelectedArtists saves a user and their manually selected artists
+// within a transaction. Users with a verified email replace the details and
+// artists of an existing user with the same email, since they have shown that
+// it is theirs.
+func saveUserSelectedArtists(ctx context.Context, tx pgx.Tx, roundID int, user UserInfo, artistIDs []string) (string, []Artist, error) {
+	// Insert or update the user (without Spotify ID)
+	userID, err := upsertUser(ctx, tx, user)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Enroll the user in the round, replacing the artists of an earlier signup
+	if err := enrollUser(ctx, tx, roundID, userID, user); err != nil {
+		return "", nil, err
+	}
+	returnArtists, err := linkSelectedArtists(ctx, tx, roundID, userID, artistIDs)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return userID, returnArtists, nil
+}
+
+// ReplaceUserArtists replaces a user's artists for a round with the given
+// Spotify artist IDs, ranked in order, and returns the artists. It fails with
+// ErrRoundClosed once the round is no longer open or has been matched, and
+// with ErrNotEnrolled if the user has not joined the round.
+func (c *DBClient) ReplaceUserArtists(ctx context.Context, roundID, userID int, artistIDs []string) ([]Artist, error) {
+	tx, err := c.conn.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the round so that it can't close or be matched while the artists are replaced
+	var status string
+	var matched bool
+	err = tx.QueryRow(ctx,
+		`SELECT status, matched_at IS NOT NULL FROM rounds WHERE round_id = $1 FOR SHARE`,
+		roundID).Scan(&status, &matched)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrRoundNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get round status: %w", err)
+	}
+	if status != RoundStatusOpen || matched {
+		return nil, ErrRoundClosed
+	}
+
+	// Lock the enrollment too, so that the user's artists can't outlive it
+	var enrolled int
+	err = tx.QueryRow(ctx,
+		`SELECT 1 FROM round_users WHERE round_id = $1 AND user_id = $2 FOR SHARE`,
+		roundID, userID).Scan(&enrolled)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotEnrolled
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to check round enrollment: %w", err)
+	}
+
+	artists, err := linkSelectedArtists(ctx, tx, roundID, strconv.Itoa(userID), artistIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return artists, nil
+}
+
+// linkSelectedArtists replaces the user's artists for the round with the given
+// Spotify artist IDs, ranked in order, and returns the artists
+func linkSelectedArtists(ctx context.Context, tx pgx.Tx, roundID int, userID string, artistIDs []string) ([]Artist, error) {
 	// Track artists to return
 	var returnArtists []Artist
 
-	// Insert the user (without Spotify ID)
-	var userID string
-	err = tx.QueryRow(ctx,
-		`INSERT INTO users (first_name, last_name, email, phone_number)
-		VALUES ($1, $2, $3, $4)
-		RETURNING user_id`,
-		user.FirstName, user.LastName, user.Email, user.PhoneNumber).Scan(&userID)
+	_, err := tx.Exec(ctx, "DELETE FROM user_artists WHERE round_id = $1 AND user_id = $2", roundID, userID)
 	if err != nil {
-		return "", nil, fmt.Errorf("failed to insert user: %w", err)
+		return nil, fmt.Errorf("failed to delete existing user-artist relationships: %w", err)
 	}
 
 	// For each artist ID, check if it exists and link to the user
@@ -197,17 +286,20 @@ func (c *DBClient) SaveUserSelectedArtists(ctx context.Context, user UserInfo, a
 			FROM artists WHERE spotify_artist_id = $1`,
 			artistID).Scan(&dbArtistID, &artistName, &genresJSON, &imagesJSON, &popularity, &spotifyURL)
 
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownArtist, artistID)
+		}
 		if err != nil {
-			return "", nil, fmt.Errorf("artist with ID %s not found: %w", artistID, err)
+			return nil, fmt.Errorf("failed to get artist with ID %s: %w", artistID, err)
 		}
 
 		// Link the user to the artist with the appropriate rank
 		_, err = tx.Exec(ctx,
-			`INSERT INTO user_artists (user_id, artist_id, rank)
-			VALUES ($1, $2, $3)`,
-			userID, dbArtistID, i+1)
+			`INSERT INTO user_artists (round_id, user_id, artist_id, rank)
+			VALUES ($1, $2, $3, $4)`,
+			roundID, userID, dbArtistID, i+1)
 		if err != nil {
-			return "", nil, fmt.Errorf("failed to link user to artist ID %s: %w", artistID, err)
+			return nil, fmt.Errorf("failed to link user to artist ID %s: %w", artistID, err)
 		}
 
 		// Create artist with all available information
@@ -219,14 +311,14 @@ func (c *DBClient) SaveUserSelectedArtists(ctx context.Context, user UserInfo, a
 		// Unmarshal genres if present
 		if len(genresJSON) > 0 {
 			if err := json.Unmarshal(genresJSON, &artist.Genres); err != nil {
-				return "", nil, fmt.Errorf("failed to unmarshal genres: %w", err)
+				return nil, fmt.Errorf("failed to unmarshal genres: %w", err)
 			}
 		}
 
 		// Unmarshal images if present
 		if len(imagesJSON) > 0 {
 			if err := json.Unmarshal(imagesJSON, &artist.Images); err != nil {
-				return "", nil, fmt.Errorf("failed to unmarshal images: %w", err)
+				return nil, fmt.Errorf("failed to unmarshal images: %w", err)
 			}
 		}
 
@@ -244,12 +336,7 @@ func (c *DBClient) SaveUserSelectedArtists(ctx context.Context, user UserInfo, a
 		returnArtists = append(returnArtists, artist)
 	}
 
-	// Commit the transaction
-	if err = tx.Commit(ctx); err != nil {
-		return "", nil, fmt.Errorf("failed to commit transaction: %w", err)
-	}
-
-	return userID, returnArtists, nil
+	return returnArtists, nil
 }
 
 // SearchArtists searches for artists in the database by name
@@ -398,3 +485,171 @@ func (c *DBClient) InsertArtist(ctx context.Context, artist Artist) error {
 
 	return nil
 }
+
+// UserArtist links a user to one of their ranked artists in a round
+type UserArtist struct {
+	UserID   int
+	ArtistID int // Internal artist ID, not the Spotify ID
+	Rank     int
+}
+
+// GetRoundUserArtists returns every user-artist link in the given round
+func (c *DBClient) GetRoundUserArtists(ctx context.Context, roundID int) ([]UserArtist, error) {
+	rows, err := c.conn.Query(ctx,
+		`SELECT user_id, artist_id, rank
+		FROM user_artists
+		WHERE round_id = $1
+		ORDER BY user_id, rank`,
+		roundID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user artists: %w", err)
+	}
+	defer rows.Close()
+
+	var userArtists []UserArtist
+	for rows.Next() {
+		var ua UserArtist
+		if err := rows.Scan(&ua.UserID, &ua.ArtistID, &ua.Rank); err != nil {
+			return nil, fmt.Errorf("failed to scan user artist row: %w", err)
+		}
+		userArtists = append(userArtists, ua)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating user artist rows: %w", err)
+	}
+
+	return userArtists, nil
+}
+
+// GetUserArtistRanks returns the user's rank for each of their artists in the
+// given round, keyed by internal artist ID
+func (c *DBClient) GetUserArtistRanks(ctx context.Context, roundID, userID int) (map[int]int, error) {
+	rows, err := c.conn.Query(ctx,
+		`SELECT artist_id, rank
+		FROM user_artists
+		WHERE round_id = $1 AND user_id = $2`,
+		roundID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user artist ranks: %w", err)
+	}
+	defer rows.Close()
+
+	ranks := make(map[int]int)
+	for rows.Next() {
+		var artistID, rank int
+		if err := rows.Scan(&artistID, &rank); err != nil {
+			return nil, fmt.Errorf("failed to scan user artist rank row: %w", err)
+		}
+		ranks[artistID] = rank
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating user artist rank rows: %w", err)
+	}
+
+	return ranks, nil
+}
+
+// GetRoundArtists returns the artists selected by users in the given round,
+// keyed by their internal artist ID
+func (c *DBClient) GetRoundArtists(ctx context.Context, roundID int) (map[int]Artist, error) {
+	rows, err := c.conn.Query(ctx,
+		`SELECT DISTINCT a.artist_id, a.spotify_artist_id, a.artist_name, a.genres, a.images, a.popularity, a.spotify_url
+		FROM artists a
+		JOIN user_artists ua ON a.artist_id = ua.artist_id
+		WHERE ua.round_id = $1`,
+		roundID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query round artists: %w", err)
+	}
+	defer rows.Close()
+
+	artists := make(map[int]Artist)
+	for rows.Next() {
+		var artistID int
+		var artist Artist
+		var genresJSON, imagesJSON []byte
+		var popularity sql.NullInt32
+		var spotifyURL sql.NullString
+
+		if err := rows.Scan(&artistID, &artist.ID, &artist.Name, &genresJSON, &imagesJSON, &popularity, &spotifyURL); err != nil {
+			return nil, fmt.Errorf("failed to scan artist row: %w", err)
+		}
+		if err := decodeArtistDetails(&artist, genresJSON, imagesJSON, popularity, spotifyURL); err != nil {
+			return nil, err
+		}
+		artists[artistID] = artist
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating artist rows: %w", err)
+	}
+
+	return artists, nil
+}
+
+// decodeArtistDetails fills in the optional artist columns read from the database
+func decodeArtistDetails(artist *Artist, genresJSON, imagesJSON []byte, popularity sql.NullInt32, spotifyURL sql.NullString) error {
+	// Unmarshal genres if present
+	if len(genresJSON) > 0 {
+		if err := json.Unmarshal(genresJSON, &artist.Genres); err != nil {
+			return fmt.Errorf("failed to unmarshal genres: %w", err)
+		}
+	}
+
+	// Unmarshal images if present
+	if len(imagesJSON) > 0 {
+		if err := json.Unmarshal(imagesJSON, &artist.Images); err != nil {
+			return fmt.Errorf("failed to unmarshal images: %w", err)
+		}
+	}
+
+	// Set popularity if present
+	if popularity.Valid {
+		artist.Popularity = int(popularity.Int32)
+	}
+
+	// Set Spotify URL if present
+	if spotifyURL.Valid {
+		artist.SpotifyURL = spotifyURL.String
+	}
+
+	return nil
+}
+
+// GetArtistsByInternalIDs returns the artists with the given internal IDs, keyed by ID
+func (c *DBClient) GetArtistsByInternalIDs(ctx context.Context, artistIDs []int) (map[int]Artist, error) {
+	rows, err := c.conn.Query(ctx,
+		`SELECT artist_id, spotify_artist_id, artist_name, genres, images, popularity, spotify_url
+		FROM artists
+		WHERE artist_id = ANY($1)`,
+		artistIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query artists: %w", err)
+	}
+	defer rows.Close()
+
+	artists := make(map[int]Artist)
+	for rows.Next() {
+		var artistID int
+		var artist Artist
+		var genresJSON, imagesJSON []byte
+		var popularity sql.NullInt32
+		var spotifyURL sql.NullString
+
+		if err := rows.Scan(&artistID, &artist.ID, &artist.Name, &genresJSON, &imagesJSON, &popularity, &spotifyURL); err != nil {
+			return nil, fmt.Errorf("failed to scan artist row: %w", err)
+		}
+		if err := decodeArtistDetails(&artist, genresJSON, imagesJSON, popularity, spotifyURL); err != nil {
+			return nil, err
+		}
+		artists[artistID] = artist
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating artist rows: %w", err)
+	}
+
+	return artists, nil
+}
@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrNotCarriedOver is returned when a user was not carried over from a round
+var ErrNotCarriedOver = errors.New("user was not carried over")
+
+// saveCarryovers records the users left unmatched in a round so that they are
+// enrolled in the next round when it opens. Carryovers from an earlier run
+// for the round that have not been enrolled yet are replaced.
+func saveCarryovers(ctx context.Context, tx pgx.Tx, roundID int, userIDs []int) error {
+	_, err := tx.Exec(ctx,
+		"DELETE FROM carryovers WHERE from_round_id = $1 AND to_round_id IS NULL", roundID)
+	if err != nil {
+		return fmt.Errorf("failed to delete existing carryovers: %w", err)
+	}
+
+	for _, userID := range userIDs {
+		_, err = tx.Exec(ctx,
+			`INSERT INTO carryovers (from_round_id, user_id)
+			VALUES ($1, $2)
+			ON CONFLICT (from_round_id, user_id) DO NOTHING`,
+			roundID, userID)
+		if err != nil {
+			return fmt.Errorf("failed to carry over user %d: %w", userID, err)
+		}
+	}
+	return nil
+}
+
+// enrollCarryovers enrolls every user waiting to be carried over into the given
+// round, along with the artists they submitted for the round they came from.
+// Users keep their side only if the round has it; everyone else is left
+// without one and picks one of the round's sides in their profile.
+func enrollCarryovers(ctx context.Context, tx pgx.Tx, roundID int) error {
+	_, err := tx.Exec(ctx,
+		`INSERT INTO round_users (round_id, user_id, carried_over_from, discovery, side)
+		SELECT $1, co.user_id, co.from_round_id, ru.discovery,
+			CASE WHEN ru.side = ANY (r.sides) THEN ru.side ELSE '' END
+		FROM carryovers co
+		JOIN round_users ru ON ru.round_id = co.from_round_id AND ru.user_id = co.user_id
+		JOIN rounds r ON r.round_id = $1
+		WHERE co.to_round_id IS NULL AND co.from_round_id <> $1
+		ON CONFLICT (round_id, user_id) DO NOTHING`,
+		roundID)
+	if err != nil {
+		return fmt.Errorf("failed to enroll carried over users: %w", err)
+	}
+
+	_, err = tx.Exec(ctx,
+		`INSERT INTO user_artists (round_id, user_id, artist_id, rank)
+		SELECT $1, ua.user_id, ua.artist_id, ua.rank
+		FROM carryovers co
+		JOIN user_artists ua ON ua.round_id = co.from_round_id AND ua.user_id = co.user_id
+		WHERE co.to_round_id IS NULL AND co.from_round_id <> $1
+		ON CONFLICT (round_id, user_id, artist_id) DO NOTHING`,
+		roundID)
+	if err != nil {
+		return fmt.Errorf("failed to copy artists of carried over users: %w", err)
+	}
+
+	_, err = tx.Exec(ctx,
+		`UPDATE carryovers SET to_round_id = $1
+		WHERE to_round_id IS NULL AND from_round_id <> $1`,
+		roundID)
+	if err != nil {
+		return fmt.Errorf("failed to mark carryovers as enrolled: %w", err)
+	}
+	return nil
+}
+
+// GetCarriedOverUserIDs returns the users enrolled in the round because they
+// were left unmatched in an earlier one
+func (c *DBClient) GetCarriedOverUserIDs(ctx context.Context, roundID int) (map[int]bool, error) {
+	rows, err := c.conn.Query(ctx,
+		`SELECT user_id FROM round_users
+		WHERE round_id = $1 AND carried_over_from IS NOT NULL`,
+		roundID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query carried over users: %w", err)
+	}
+	defer rows.Close()
+
+	userIDs := make(map[int]bool)
+	for rows.Next() {
+		var userID int
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("failed to scan carried over user row: %w", err)
+		}
+		userIDs[userID] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating carried over user rows: %w", err)
+	}
+
+	return userIDs, nil
+}
+
+// GetUserCarryover returns the round the user was carried over to from the given
+// round, or 0 if the next round has not opened yet
+func (c *DBClient) GetUserCarryover(ctx context.Context, fromRoundID, userID int) (int, error) {
+	var toRoundID *int
+	err := c.conn.QueryRow(ctx,
+		`SELECT to_round_id FROM carryovers
+		WHERE from_round_id = $1 AND user_id = $2`,
+		fromRoundID, userID).Scan(&toRoundID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, ErrNotCarriedOver
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get carryover: %w", err)
+	}
+	if toRoundID == nil {
+		return 0, nil
+	}
+	return *toRoundID, nil
+}
@@ -2,10 +2,16 @@ package db
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"os"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// uniqueViolationCode is the Postgres error code for unique constraint violations
+const uniqueViolationCode = "23505"
+
 type DBClient struct {
 	conn *pgxpool.Pool
 }
@@ -21,3 +27,30 @@ func NewClient(connString string) (*DBClient, error) {
 func (c *DBClient) Close() {
 	c.conn.Close()
 }
+
+// NewClientFromEnv connects using the DB_HOST, DB_USERNAME, DB_NAME and DB_PASSWORD
+// environment variables. The server and the matching tools share it so that
+// they always connect the same way.
+func NewClientFromEnv() (*DBClient, error) {
+	dbAddr := os.Getenv("DB_HOST")
+	if dbAddr == "" {
+		dbAddr = "localhost:5432"
+	}
+
+	username := os.Getenv("DB_USERNAME")
+	if username == "" {
+		username = "spotifyuser"
+	}
+
+	dbName := os.Getenv("DB_NAME")
+	if dbName == "" {
+		dbName = "spotify"
+	}
+
+	password := os.Getenv("DB_PASSWORD")
+	if password == "" {
+		return nil, errors.New("DB_PASSWORD environment variable not set")
+	}
+
+	return NewClient(fmt.Sprintf("postgres://%s:%s@%s/%s", username, password, dbAddr, dbName))
+}
@@ -0,0 +1,171 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+// FeedbackBuckets is the number of match score ranges feedback is grouped into
+const FeedbackBuckets = 10
+
+// MatchFeedback is one user's review of a match they were in
+type MatchFeedback struct {
+	MatchID   int
+	UserID    int
+	Connected bool
+	Rating    int
+	Comment   string
+}
+
+// FeedbackBucket summarizes the feedback on matches within a score range
+type FeedbackBucket struct {
+	MinScore   int
+	MaxScore   int
+	Matches    int
+	Responses  int
+	Connected  int
+	MeanRating float64
+}
+
+// RoundFeedback summarizes the feedback on a round's matches
+type RoundFeedback struct {
+	Matches    int
+	Responses  int
+	Connected  int
+	MeanRating float64
+	// ScoreRatingCorrelation is the Pearson correlation between the match score
+	// and the rating of each response, or zero with too few responses
+	ScoreRatingCorrelation float64
+	// Buckets group the matches by score in ranges of ten, the last of which
+	// also holds perfect scores
+	Buckets  []FeedbackBucket
+	Comments []string
+}
+
+// SaveMatchFeedback stores a user's feedback on a match, replacing any they
+// submitted before
+func (c *DBClient) SaveMatchFeedback(ctx context.Context, f MatchFeedback) error {
+	_, err := c.conn.Exec(ctx,
+		`INSERT INTO match_feedback (match_id, user_id, connected, rating, comment)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (match_id, user_id) DO UPDATE
+		SET connected = EXCLUDED.connected,
+			rating = EXCLUDED.rating,
+			comment = EXCLUDED.comment,
+			submitted_at = CURRENT_TIMESTAMP`,
+		f.MatchID, f.UserID, f.Connected, f.Rating, f.Comment)
+	if err != nil {
+		return fmt.Errorf("failed to save match feedback: %w", err)
+	}
+	return nil
+}
+
+// GetRoundMatchFeedback returns every feedback response on the matches of a round
+func (c *DBClient) GetRoundMatchFeedback(ctx context.Context, roundID int) ([]MatchFeedback, error) {
+	rows, err := c.conn.Query(ctx,
+		`SELECT f.match_id, f.user_id, f.connected, f.rating, f.comment
+		FROM match_feedback f
+		JOIN matches m ON m.match_id = f.match_id
+		WHERE m.round_id = $1
+		ORDER BY f.match_id, f.user_id`,
+		roundID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query match feedback: %w", err)
+	}
+	defer rows.Close()
+
+	var feedback []MatchFeedback
+	for rows.Next() {
+		var f MatchFeedback
+		if err := rows.Scan(&f.MatchID, &f.UserID, &f.Connected, &f.Rating, &f.Comment); err != nil {
+			return nil, fmt.Errorf("failed to scan match feedback row: %w", err)
+		}
+		feedback = append(feedback, f)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating match feedback rows: %w", err)
+	}
+
+	return feedback, nil
+}
+
+// GetRoundFeedback summarizes the feedback on the matches of a round
+func (c *DBClient) GetRoundFeedback(ctx context.Context, roundID int) (RoundFeedback, error) {
+	feedback := RoundFeedback{Buckets: make([]FeedbackBucket, FeedbackBuckets)}
+	for i := range feedback.Buckets {
+		feedback.Buckets[i].MinScore = i * 100 / FeedbackBuckets
+		feedback.Buckets[i].MaxScore = (i+1)*100/FeedbackBuckets - 1
+	}
+	feedback.Buckets[FeedbackBuckets-1].MaxScore = 100
+
+	err := c.conn.QueryRow(ctx,
+		`SELECT COUNT(DISTINCT m.match_id), COUNT(f.match_id),
+			COUNT(*) FILTER (WHERE f.connected),
+			COALESCE(AVG(f.rating)::float8, 0),
+			COALESCE(corr(m.match_score, f.rating), 0)
+		FROM matches m
+		LEFT JOIN match_feedback f ON f.match_id = m.match_id
+		WHERE m.round_id = $1`,
+		roundID).Scan(&feedback.Matches, &feedback.Responses, &feedback.Connected,
+		&feedback.MeanRating, &feedback.ScoreRatingCorrelation)
+	if err != nil {
+		return RoundFeedback{}, fmt.Errorf("failed to get round feedback: %w", err)
+	}
+
+	rows, err := c.conn.Query(ctx,
+		`SELECT LEAST(m.match_score * $2 / 100, $2 - 1) AS bucket,
+			COUNT(DISTINCT m.match_id), COUNT(f.match_id),
+			COUNT(*) FILTER (WHERE f.connected),
+			COALESCE(AVG(f.rating)::float8, 0)
+		FROM matches m
+		LEFT JOIN match_feedback f ON f.match_id = m.match_id
+		WHERE m.round_id = $1
+		GROUP BY bucket`,
+		roundID, FeedbackBuckets)
+	if err != nil {
+		return RoundFeedback{}, fmt.Errorf("failed to query feedback buckets: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var i int
+		var b FeedbackBucket
+		if err := rows.Scan(&i, &b.Matches, &b.Responses, &b.Connected, &b.MeanRating); err != nil {
+			return RoundFeedback{}, fmt.Errorf("failed to scan feedback bucket row: %w", err)
+		}
+		if i < 0 || i >= FeedbackBuckets {
+			continue
+		}
+		b.MinScore, b.MaxScore = feedback.Buckets[i].MinScore, feedback.Buckets[i].MaxScore
+		feedback.Buckets[i] = b
+	}
+	if err := rows.Err(); err != nil {
+		return RoundFeedback{}, fmt.Errorf("error iterating feedback bucket rows: %w", err)
+	}
+
+	rows, err = c.conn.Query(ctx,
+		`SELECT f.comment
+		FROM match_feedback f
+		JOIN matches m ON m.match_id = f.match_id
+		WHERE m.round_id = $1 AND f.comment <> ''
+		ORDER BY f.submitted_at DESC`,
+		roundID)
+	if err != nil {
+		return RoundFeedback{}, fmt.Errorf("failed to query feedback comments: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var comment string
+		if err := rows.Scan(&comment); err != nil {
+			return RoundFeedback{}, fmt.Errorf("failed to scan feedback comment row: %w", err)
+		}
+		feedback.Comments = append(feedback.Comments, comment)
+	}
+	if err := rows.Err(); err != nil {
+		return RoundFeedback{}, fmt.Errorf("error iterating feedback comment rows: %w", err)
+	}
+
+	return feedback, nil
+}
@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// MatchGroup represents a stored group of users matched together in a round
+type MatchGroup struct {
+	ID      int
+	RoundID int
+	UserIDs []int
+	// Similarity is the mean similarity over all pairs in the group
+	Similarity float64
+	MatchScore int
+	// SharedArtistIDs holds internal artist IDs every member listed, best shared artists first
+	SharedArtistIDs []int
+	// SharedGenres holds the genres every member listens to, strongest first
+	SharedGenres []string
+	CreatedAt    time.Time
+}
+
+// PartnerIDs returns the IDs of the other users in the group
+func (g MatchGroup) PartnerIDs(userID int) []int {
+	var ids []int
+	for _, id := range g.UserIDs {
+		if id != userID {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// SaveMatchGroups replaces the stored results for a round with the given groups,
+// carries the unmatched users over to the next round and marks the round as
+// matched. Like SaveMatches, it only saves the results of an open round when
+// closing it, and only replaces matches that users were notified about or
+// gave feedback on when forced.
+func (c *DBClient) SaveMatchGroups(ctx context.Context, roundID int, groups []MatchGroup, unmatchedUserIDs []int, opts SaveOptions) error {
+	// Begin a transaction
+	tx, err := c.conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	// Ensure the transaction is rolled back if an error occurs
+	defer tx.Rollback(ctx)
+
+	if err := lockRoundForResults(ctx, tx, roundID, opts.Close); err != nil {
+		return err
+	}
+	if err := deleteRoundResults(ctx, tx, roundID, opts.Force); err != nil {
+		return err
+	}
+
+	for _, g := range groups {
+		sharedArtistIDs := g.SharedArtistIDs
+		if sharedArtistIDs == nil {
+			sharedArtistIDs = []int{}
+		}
+		sharedGenres := g.SharedGenres
+		if sharedGenres == nil {
+			sharedGenres = []string{}
+		}
+
+		var groupID int
+		err = tx.QueryRow(ctx,
+			`INSERT INTO match_groups (round_id, similarity, match_score, shared_artist_ids, shared_genres)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING group_id`,
+			roundID, g.Similarity, g.MatchScore, sharedArtistIDs, sharedGenres).Scan(&groupID)
+		if err != nil {
+			return fmt.Errorf("failed to insert match group: %w", err)
+		}
+
+		for _, userID := range g.UserIDs {
+			_, err = tx.Exec(ctx,
+				`INSERT INTO match_group_members (group_id, round_id, user_id)
+				VALUES ($1, $2, $3)`,
+				groupID, roundID, userID)
+			if err != nil {
+				return fmt.Errorf("failed to add user %d to match group: %w", userID, err)
+			}
+		}
+	}
+
+	if err := saveCarryovers(ctx, tx, roundID, unmatchedUserIDs); err != nil {
+		return err
+	}
+
+	if err := markRoundMatched(ctx, tx, roundID); err != nil {
+		return err
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetUserMatchGroup returns the group the user was placed in for the given round
+func (c *DBClient) GetUserMatchGroup(ctx context.Context, roundID, userID int) (MatchGroup, error) {
+	var g MatchGroup
+	err := c.conn.QueryRow(ctx,
+		`SELECT g.group_id, g.round_id, g.similarity, g.match_score, g.shared_artist_ids, g.shared_genres, g.created_at,
+			ARRAY(SELECT m.user_id FROM match_group_members m WHERE m.group_id = g.group_id ORDER BY m.user_id)
+		FROM match_groups g
+		JOIN match_group_members gm ON gm.group_id = g.group_id
+		WHERE gm.round_id = $1 AND gm.user_id = $2`,
+		roundID, userID).Scan(&g.ID, &g.RoundID, &g.Similarity, &g.MatchScore, &g.SharedArtistIDs, &g.SharedGenres,
+		&g.CreatedAt, &g.UserIDs)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return MatchGroup{}, ErrMatchNotFound
+	}
+	if err != nil {
+		return MatchGroup{}, fmt.Errorf("failed to get match group: %w", err)
+	}
+	return g, nil
+}
@@ -0,0 +1,44 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+// UserPair is two users who were matched together, with the lower user ID first
+type UserPair struct {
+	UserAID int
+	UserBID int
+}
+
+// GetPastPairs returns every pair of users matched together in a round other
+// than the given one, including users who shared a group
+func (c *DBClient) GetPastPairs(ctx context.Context, excludeRoundID int) ([]UserPair, error) {
+	rows, err := c.conn.Query(ctx,
+		`SELECT user_a_id, user_b_id FROM matches WHERE round_id <> $1
+		UNION
+		SELECT a.user_id, b.user_id
+		FROM match_group_members a
+		JOIN match_group_members b ON b.group_id = a.group_id AND a.user_id < b.user_id
+		WHERE a.round_id <> $1`,
+		excludeRoundID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query past pairs: %w", err)
+	}
+	defer rows.Close()
+
+	var pairs []UserPair
+	for rows.Next() {
+		var p UserPair
+		if err := rows.Scan(&p.UserAID, &p.UserBID); err != nil {
+			return nil, fmt.Errorf("failed to scan past pair row: %w", err)
+		}
+		pairs = append(pairs, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating past pair rows: %w", err)
+	}
+
+	return pairs, nil
+}
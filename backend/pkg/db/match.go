@@ -0,0 +1,399 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var (
+	// ErrMatchNotFound is returned when a user has no stored match for a round
+	ErrMatchNotFound = errors.New("match not found")
+	// ErrContactRevealed is returned when responding to a match whose contact
+	// details have already been shared
+	ErrContactRevealed = errors.New("contact details have already been shared")
+	// ErrRoundNotified is returned when replacing the results of a round whose
+	// matches users have already been notified about
+	ErrRoundNotified = errors.New("users have already been notified about this round's matches")
+	// ErrRoundHasFeedback is returned when replacing the results of a round
+	// whose matches users have already given feedback on
+	ErrRoundHasFeedback = errors.New("users have already given feedback on this round's matches")
+	// ErrUserMatchedTwice is returned when saving matches that put a user in more than one match
+	ErrUserMatchedTwice = errors.New("user is in more than one match")
+)
+
+// SaveOptions controls how SaveMatches and SaveMatchGroups store a round's results
+type SaveOptions struct {
+	// Force replaces matches that users were notified about or gave feedback
+	// on, deleting the notifications and feedback
+	Force bool
+	// Close closes the round if it is still open, so that nobody can sign up
+	// once it has been matched
+	Close bool
+}
+
+// Responses to sharing contact details with a match
+const (
+	ContactResponsePending  = "pending"
+	ContactResponseAccepted = "accepted"
+	ContactResponseDeclined = "declined"
+)
+
+// Match represents a stored pairing of two users in a round
+type Match struct {
+	ID         int
+	RoundID    int
+	UserAID    int
+	UserBID    int
+	Similarity float64
+	MatchScore int
+	// SharedArtistIDs holds internal artist IDs, best shared artists first
+	SharedArtistIDs []int
+	// ArtistContributions holds how much each shared artist adds to the
+	// similarity, in the same order as SharedArtistIDs
+	ArtistContributions []float64
+	// GenreContribution is how much genre overlap adds to the similarity
+	GenreContribution float64
+	// SharedGenres holds the genres both users listen to, strongest first
+	SharedGenres []string
+	// UserAResponse and UserBResponse are whether each user agreed to share
+	// contact details with the other
+	UserAResponse string
+	UserBResponse string
+	// RevealedAt is set once both users accepted
+	RevealedAt *time.Time
+	// UserALinkGeneration and UserBLinkGeneration are the generations of
+	// the links each user was sent; links of earlier generations are revoked
+	UserALinkGeneration int
+	UserBLinkGeneration int
+	CreatedAt           time.Time
+}
+
+// PartnerID returns the ID of the other user in the match
+func (m Match) PartnerID(userID int) int {
+	if m.UserAID == userID {
+		return m.UserBID
+	}
+	return m.UserAID
+}
+
+// Response returns whether the user agreed to share contact details with their partner
+func (m Match) Response(userID int) string {
+	if m.UserAID == userID {
+		return m.UserAResponse
+	}
+	return m.UserBResponse
+}
+
+// LinkGeneration returns the generation of the user's current match links
+func (m Match) LinkGeneration(userID int) int {
+	if m.UserAID == userID {
+		return m.UserALinkGeneration
+	}
+	return m.UserBLinkGeneration
+}
+
+const matchColumns = `match_id, round_id, user_a_id, user_b_id, similarity, match_score,
+	shared_artist_ids, artist_contributions, genre_contribution, shared_genres,
+	user_a_response, user_b_response, revealed_at, user_a_link_generation, user_b_link_generation, created_at`
+
+func scanMatch(row pgx.Row) (Match, error) {
+	var m Match
+	err := row.Scan(&m.ID, &m.RoundID, &m.UserAID, &m.UserBID, &m.Similarity, &m.MatchScore,
+		&m.SharedArtistIDs, &m.ArtistContributions, &m.GenreContribution, &m.SharedGenres,
+		&m.UserAResponse, &m.UserBResponse, &m.RevealedAt, &m.UserALinkGeneration, &m.UserBLinkGeneration, &m.CreatedAt)
+	return m, err
+}
+
+// SaveMatches replaces the stored matches for a round, carries the unmatched
+// users over to the next round and marks the round as matched. The ID of each
+// stored match is filled in. It fails with ErrRoundOpen if the round is still
+// open, unless opts.Close is set. It fails with ErrRoundNotified if
+// notifications were enqueued for the round's matches, or ErrRoundHasFeedback
+// if users gave feedback on them, unless opts.Force is set, in which case the
+// notifications and feedback are deleted and notifications will be sent again
+// for the new matches.
+func (c *DBClient) SaveMatches(ctx context.Context, roundID int, matches []Match, unmatchedUserIDs []int, opts SaveOptions) error {
+	// Begin a transaction
+	tx, err := c.conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	// Ensure the transaction is rolled back if an error occurs
+	defer tx.Rollback(ctx)
+
+	if err := lockRoundForResults(ctx, tx, roundID, opts.Close); err != nil {
+		return err
+	}
+	if err := deleteRoundResults(ctx, tx, roundID, opts.Force); err != nil {
+		return err
+	}
+
+	for i, m := range matches {
+		// Store each pair with the lower user ID first
+		userA, userB := m.UserAID, m.UserBID
+		if userA > userB {
+			userA, userB = userB, userA
+		}
+		sharedArtistIDs := m.SharedArtistIDs
+		if sharedArtistIDs == nil {
+			sharedArtistIDs = []int{}
+		}
+		artistContributions := m.ArtistContributions
+		if artistContributions == nil {
+			artistContributions = []float64{}
+		}
+		sharedGenres := m.SharedGenres
+		if sharedGenres == nil {
+			sharedGenres = []string{}
+		}
+
+		err = tx.QueryRow(ctx,
+			`INSERT INTO matches (round_id, user_a_id, user_b_id, similarity, match_score,
+				shared_artist_ids, artist_contributions, genre_contribution, shared_genres)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			RETURNING match_id`,
+			roundID, userA, userB, m.Similarity, m.MatchScore,
+			sharedArtistIDs, artistContributions, m.GenreContribution, sharedGenres).Scan(&matches[i].ID)
+		if err != nil {
+			return fmt.Errorf("failed to insert match for users %d and %d: %w", userA, userB, err)
+		}
+
+		for _, userID := range []int{userA, userB} {
+			_, err = tx.Exec(ctx,
+				`INSERT INTO match_users (round_id, user_id, match_id) VALUES ($1, $2, $3)`,
+				roundID, userID, matches[i].ID)
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+				return fmt.Errorf("%w: user %d", ErrUserMatchedTwice, userID)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to record match of user %d: %w", userID, err)
+			}
+		}
+	}
+
+	if err := saveCarryovers(ctx, tx, roundID, unmatchedUserIDs); err != nil {
+		return err
+	}
+
+	if err := markRoundMatched(ctx, tx, roundID); err != nil {
+		return err
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// deleteRoundResults removes the pairs and groups of any previous run for a round,
+// so that switching a round between modes does not leave stale results behind.
+// Matches that notifications were enqueued for or that users gave feedback on
+// are only removed when forced, along with their notifications and feedback.
+func deleteRoundResults(ctx context.Context, tx pgx.Tx, roundID int, force bool) error {
+	if !force {
+		var notified, hasFeedback bool
+		err := tx.QueryRow(ctx,
+			`SELECT
+				EXISTS (SELECT 1 FROM outbox o JOIN matches m ON m.match_id = o.match_id WHERE m.round_id = $1),
+				EXISTS (SELECT 1 FROM match_feedback f JOIN matches m ON m.match_id = f.match_id WHERE m.round_id = $1)`,
+			roundID).Scan(&notified, &hasFeedback)
+		if err != nil {
+			return fmt.Errorf("failed to check for notifications and feedback: %w", err)
+		}
+		if hasFeedback {
+			return ErrRoundHasFeedback
+		}
+		if notified {
+			return ErrRoundNotified
+		}
+	}
+	_, err := tx.Exec(ctx,
+		`DELETE FROM outbox WHERE match_id IN (SELECT match_id FROM matches WHERE round_id = $1)`, roundID)
+	if err != nil {
+		return fmt.Errorf("failed to delete existing notifications: %w", err)
+	}
+	_, err = tx.Exec(ctx,
+		`DELETE FROM match_feedback WHERE match_id IN (SELECT match_id FROM matches WHERE round_id = $1)`, roundID)
+	if err != nil {
+		return fmt.Errorf("failed to delete existing feedback: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, "DELETE FROM matches WHERE round_id = $1", roundID)
+	if err != nil {
+		return fmt.Errorf("failed to delete existing matches: %w", err)
+	}
+	// Group members are removed along with their group
+	_, err = tx.Exec(ctx, "DELETE FROM match_groups WHERE round_id = $1", roundID)
+	if err != nil {
+		return fmt.Errorf("failed to delete existing match groups: %w", err)
+	}
+	return nil
+}
+
+// lockRoundForResults locks the round while its results are saved, so that
+// nobody can sign up in the meantime. A round that is still open is closed if
+// closeRound is set and refused with ErrRoundOpen otherwise, since users who
+// signed up after it was matched would never be matched or carried over.
+func lockRoundForResults(ctx context.Context, tx pgx.Tx, roundID int, closeRound bool) error {
+	var status string
+	err := tx.QueryRow(ctx, `SELECT status FROM rounds WHERE round_id = $1 FOR UPDATE`, roundID).Scan(&status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrRoundNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock round: %w", err)
+	}
+	if status != RoundStatusOpen {
+		return nil
+	}
+	if !closeRound {
+		return ErrRoundOpen
+	}
+
+	_, err = tx.Exec(ctx,
+		`UPDATE rounds SET status = $2, closed_at = CURRENT_TIMESTAMP WHERE round_id = $1`,
+		roundID, RoundStatusClosed)
+	if err != nil {
+		return fmt.Errorf("failed to close round: %w", err)
+	}
+	return nil
+}
+
+// markRoundMatched records when the round's results were saved
+func markRoundMatched(ctx context.Context, tx pgx.Tx, roundID int) error {
+	_, err := tx.Exec(ctx, "UPDATE rounds SET matched_at = CURRENT_TIMESTAMP WHERE round_id = $1", roundID)
+	if err != nil {
+		return fmt.Errorf("failed to mark round as matched: %w", err)
+	}
+	return nil
+}
+
+// GetUserMatch returns the user's match in the given round
+func (c *DBClient) GetUserMatch(ctx context.Context, roundID, userID int) (Match, error) {
+	m, err := scanMatch(c.conn.QueryRow(ctx,
+		`SELECT `+matchColumns+` FROM matches
+		WHERE round_id = $1 AND (user_a_id = $2 OR user_b_id = $2)`,
+		roundID, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Match{}, ErrMatchNotFound
+	}
+	if err != nil {
+		return Match{}, fmt.Errorf("failed to get match: %w", err)
+	}
+	return m, nil
+}
+
+// GetRoundMatches returns the stored matches of a round
+func (c *DBClient) GetRoundMatches(ctx context.Context, roundID int) ([]Match, error) {
+	rows, err := c.conn.Query(ctx,
+		`SELECT `+matchColumns+` FROM matches WHERE round_id = $1 ORDER BY match_id`, roundID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query matches: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []Match
+	for rows.Next() {
+		m, err := scanMatch(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan match row: %w", err)
+		}
+		matches = append(matches, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating match rows: %w", err)
+	}
+
+	return matches, nil
+}
+
+// GetMatch returns the match with the given ID
+func (c *DBClient) GetMatch(ctx context.Context, matchID int) (Match, error) {
+	m, err := scanMatch(c.conn.QueryRow(ctx,
+		`SELECT `+matchColumns+` FROM matches WHERE match_id = $1`, matchID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Match{}, ErrMatchNotFound
+	}
+	if err != nil {
+		return Match{}, fmt.Errorf("failed to get match: %w", err)
+	}
+	return m, nil
+}
+
+// RespondToMatch records whether the user agrees to share contact details
+// with their partner. Once both users accepted the contact details are
+// revealed and the given notifications are enqueued in the same transaction,
+// so they are sent exactly when the match is revealed. Responses can no
+// longer be changed after that.
+func (c *DBClient) RespondToMatch(ctx context.Context, matchID, userID int, accept bool, revealNotifications []Notification) (Match, error) {
+	response := ContactResponseDeclined
+	if accept {
+		response = ContactResponseAccepted
+	}
+
+	tx, err := c.conn.Begin(ctx)
+	if err != nil {
+		return Match{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	m, err := scanMatch(tx.QueryRow(ctx,
+		`UPDATE matches
+		SET user_a_response = CASE WHEN user_a_id = $2 THEN $3 ELSE user_a_response END,
+			user_b_response = CASE WHEN user_b_id = $2 THEN $3 ELSE user_b_response END
+		WHERE match_id = $1 AND (user_a_id = $2 OR user_b_id = $2) AND revealed_at IS NULL
+		RETURNING `+matchColumns,
+		matchID, userID, response))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Match{}, ErrContactRevealed
+	}
+	if err != nil {
+		return Match{}, fmt.Errorf("failed to save match response: %w", err)
+	}
+
+	if m.UserAResponse == ContactResponseAccepted && m.UserBResponse == ContactResponseAccepted {
+		err := tx.QueryRow(ctx,
+			`UPDATE matches SET revealed_at = CURRENT_TIMESTAMP WHERE match_id = $1 RETURNING revealed_at`,
+			matchID).Scan(&m.RevealedAt)
+		if err != nil {
+			return Match{}, fmt.Errorf("failed to reveal match: %w", err)
+		}
+		if _, err := enqueueNotifications(ctx, tx, revealNotifications); err != nil {
+			return Match{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return Match{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return m, nil
+}
+
+// RevokeMatchLinks moves the user's links for a match to a new generation, so
+// that every link they were sent for it stops working. The other user's links
+// are unaffected.
+func (c *DBClient) RevokeMatchLinks(ctx context.Context, matchID, userID int) (Match, error) {
+	m, err := scanMatch(c.conn.QueryRow(ctx,
+		`UPDATE matches
+		SET user_a_link_generation = user_a_link_generation + CASE WHEN user_a_id = $2 THEN 1 ELSE 0 END,
+			user_b_link_generation = user_b_link_generation + CASE WHEN user_b_id = $2 THEN 1 ELSE 0 END
+		WHERE match_id = $1 AND (user_a_id = $2 OR user_b_id = $2)
+		RETURNING `+matchColumns,
+		matchID, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Match{}, ErrMatchNotFound
+	}
+	if err != nil {
+		return Match{}, fmt.Errorf("failed to revoke match links: %w", err)
+	}
+	return m, nil
+}
@@ -0,0 +1,215 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Notification statuses as stored in the outbox table
+const (
+	NotificationStatusPending = "pending"
+	NotificationStatusSending = "sending"
+	NotificationStatusSent    = "sent"
+	NotificationStatusFailed  = "failed"
+)
+
+// Notification is a message to a user about one of their matches, rendered
+// when it is enqueued in the outbox
+type Notification struct {
+	ID        int
+	UserID    int
+	MatchID   int
+	Channel   string
+	Template  string
+	Sender    string
+	Recipient string
+	Subject   string
+	Body      string
+	Status    string
+	Attempts  int
+	LastError string
+	// ProviderMessageID is the ID the mailer assigned to the delivered message
+	ProviderMessageID string
+	SentAt            *time.Time
+}
+
+const notificationColumns = `notification_id, user_id, match_id, channel, template, sender, recipient,
+	subject, body, status, attempts, last_error, provider_message_id, sent_at`
+
+func scanNotification(row pgx.Row) (Notification, error) {
+	var n Notification
+	err := row.Scan(&n.ID, &n.UserID, &n.MatchID, &n.Channel, &n.Template, &n.Sender, &n.Recipient,
+		&n.Subject, &n.Body, &n.Status, &n.Attempts, &n.LastError, &n.ProviderMessageID, &n.SentAt)
+	return n, err
+}
+
+// EnqueueNotifications adds notifications to the outbox. Notifications already
+// enqueued for the same user, match, channel and template are left as they are,
+// so enqueueing again never sends anything twice. It returns how many were added.
+func (c *DBClient) EnqueueNotifications(ctx context.Context, notifications []Notification) (int, error) {
+	tx, err := c.conn.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	added, err := enqueueNotifications(ctx, tx, notifications)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return added, nil
+}
+
+// enqueueNotifications adds notifications to the outbox within a transaction,
+// skipping those already enqueued, and returns how many were added
+func enqueueNotifications(ctx context.Context, tx pgx.Tx, notifications []Notification) (int, error) {
+	var added int
+	for _, n := range notifications {
+		tag, err := tx.Exec(ctx,
+			`INSERT INTO outbox (user_id, match_id, channel, template, sender, recipient, subject, body)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT (user_id, match_id, channel, template) DO NOTHING`,
+			n.UserID, n.MatchID, n.Channel, n.Template, n.Sender, n.Recipient, n.Subject, n.Body)
+		if err != nil {
+			return 0, fmt.Errorf("failed to enqueue notification: %w", err)
+		}
+		added += int(tag.RowsAffected())
+	}
+	return added, nil
+}
+
+// ClaimNotifications marks up to limit pending notifications that are due as
+// being sent and returns them. Concurrent workers never claim the same one.
+func (c *DBClient) ClaimNotifications(ctx context.Context, limit int) ([]Notification, error) {
+	rows, err := c.conn.Query(ctx,
+		`UPDATE outbox
+		SET status = 'sending', attempts = attempts + 1, claimed_at = CURRENT_TIMESTAMP
+		WHERE notification_id IN (
+			SELECT notification_id FROM outbox
+			WHERE status = 'pending' AND next_attempt_at <= CURRENT_TIMESTAMP
+			ORDER BY next_attempt_at, notification_id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+notificationColumns,
+		limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var notifications []Notification
+	for rows.Next() {
+		n, err := scanNotification(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan notification row: %w", err)
+		}
+		notifications = append(notifications, n)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating notification rows: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// MarkNotificationSent records that a claimed notification was delivered
+func (c *DBClient) MarkNotificationSent(ctx context.Context, notificationID int, providerMessageID string) error {
+	_, err := c.conn.Exec(ctx,
+		`UPDATE outbox
+		SET status = 'sent', provider_message_id = $2, last_error = '', sent_at = CURRENT_TIMESTAMP
+		WHERE notification_id = $1`,
+		notificationID, providerMessageID)
+	if err != nil {
+		return fmt.Errorf("failed to mark notification sent: %w", err)
+	}
+	return nil
+}
+
+// RetryNotification records a failed delivery of a claimed notification and
+// makes it due again after the given delay
+func (c *DBClient) RetryNotification(ctx context.Context, notificationID int, deliveryErr string, delay time.Duration) error {
+	_, err := c.conn.Exec(ctx,
+		`UPDATE outbox
+		SET status = 'pending', last_error = $2,
+			next_attempt_at = CURRENT_TIMESTAMP + $3 * INTERVAL '1 millisecond'
+		WHERE notification_id = $1`,
+		notificationID, deliveryErr, delay.Milliseconds())
+	if err != nil {
+		return fmt.Errorf("failed to reschedule notification: %w", err)
+	}
+	return nil
+}
+
+// FailNotification records that a claimed notification could not be delivered
+// and will not be retried
+func (c *DBClient) FailNotification(ctx context.Context, notificationID int, deliveryErr string) error {
+	_, err := c.conn.Exec(ctx,
+		`UPDATE outbox SET status = 'failed', last_error = $2 WHERE notification_id = $1`,
+		notificationID, deliveryErr)
+	if err != nil {
+		return fmt.Errorf("failed to mark notification failed: %w", err)
+	}
+	return nil
+}
+
+// FailAbandonedNotifications marks notifications that have been sending for
+// longer than the lease as failed. Their worker stopped before recording the
+// outcome, so they may have been delivered and are not retried automatically.
+func (c *DBClient) FailAbandonedNotifications(ctx context.Context, lease time.Duration) (int, error) {
+	tag, err := c.conn.Exec(ctx,
+		`UPDATE outbox
+		SET status = 'failed', last_error = 'worker stopped while sending, delivery unknown'
+		WHERE status = 'sending' AND claimed_at < CURRENT_TIMESTAMP - $1 * INTERVAL '1 millisecond'`,
+		lease.Milliseconds())
+	if err != nil {
+		return 0, fmt.Errorf("failed to fail abandoned notifications: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// RequeueFailedNotifications makes the failed notifications due again, for
+// after the cause has been fixed. It returns how many were requeued.
+func (c *DBClient) RequeueFailedNotifications(ctx context.Context) (int, error) {
+	tag, err := c.conn.Exec(ctx,
+		`UPDATE outbox
+		SET status = 'pending', attempts = 0, next_attempt_at = CURRENT_TIMESTAMP
+		WHERE status = 'failed'`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to requeue notifications: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// GetOutboxCounts returns the number of notifications in each status
+func (c *DBClient) GetOutboxCounts(ctx context.Context) (map[string]int, error) {
+	rows, err := c.conn.Query(ctx, `SELECT status, COUNT(*) FROM outbox GROUP BY status`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count notifications: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var status string
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan notification count row: %w", err)
+		}
+		counts[status] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating notification count rows: %w", err)
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,290 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Round statuses as stored in the rounds table
+const (
+	RoundStatusDraft  = "draft"
+	RoundStatusOpen   = "open"
+	RoundStatusClosed = "closed"
+)
+
+var (
+	// ErrRoundNotFound is returned when a round does not exist
+	ErrRoundNotFound = errors.New("round not found")
+	// ErrNoOpenRound is returned when no round is currently accepting signups
+	ErrNoOpenRound = errors.New("no round is currently open")
+	// ErrRoundAlreadyOpen is returned when opening a round while another one is open
+	ErrRoundAlreadyOpen = errors.New("another round is already open")
+	// ErrInvalidRoundTransition is returned when a round cannot move to the requested status
+	ErrInvalidRoundTransition = errors.New("invalid round status transition")
+	// ErrRoundClosed is returned when changing a user's signup for a round that
+	// is no longer open or has already been matched
+	ErrRoundClosed = errors.New("round is no longer open")
+	// ErrRoundFull is returned when enrolling a new user in a round that has reached its capacity
+	ErrRoundFull = errors.New("round is full")
+	// ErrNotEnrolled is returned when changing the signup of a user who has not joined the round
+	ErrNotEnrolled = errors.New("user has not joined this round")
+	// ErrRoundSettingsLocked is returned when changing the settings of a round that has closed or been matched
+	ErrRoundSettingsLocked = errors.New("settings can't be changed once a round has closed or been matched")
+	// ErrSidesLocked is returned when changing the sides of a round that users have already joined
+	ErrSidesLocked = errors.New("sides can't be changed once users have joined the round")
+	// ErrRoundOpen is returned when saving the results of a round that is still accepting signups
+	ErrRoundOpen = errors.New("round is still open")
+)
+
+// RoundSettings controls how a round is matched
+type RoundSettings struct {
+	// Scorer is the name of the similarity strategy used to compare users
+	Scorer string
+	// GenreWeight is the share (0-1) of the similarity that comes from genre overlap
+	GenreWeight float64
+	// Mode is "pairs", "groups", "stable", "discovery" or "bipartite"
+	Mode string
+	// Objective is what pairs mode optimizes, "total" or "bottleneck"
+	Objective string
+	// GroupSize is the minimum number of users per group in group mode
+	GroupSize int
+	// MinScore is the lowest match score (0-100) a match may have; users who
+	// cannot reach it are carried over to the next round
+	MinScore int
+	// Candidates limits how many likely matches each user is scored against,
+	// 0 scores every pair
+	Candidates int
+	// Sides names the two sides users choose from at signup, empty for rounds
+	// without sides. Bipartite mode only matches users across sides.
+	Sides []string
+}
+
+// Round represents a single round of signups and matching
+type Round struct {
+	ID        int
+	Name      string
+	Capacity  int
+	Status    string
+	Settings  RoundSettings
+	CreatedAt time.Time
+	OpenedAt  *time.Time
+	ClosedAt  *time.Time
+	MatchedAt *time.Time
+}
+
+const roundColumns = `round_id, name, capacity, status, scorer, genre_weight, mode, objective, group_size, min_score,
+	candidates, sides, created_at, opened_at, closed_at, matched_at`
+
+func scanRound(row pgx.Row) (Round, error) {
+	var round Round
+	err := row.Scan(&round.ID, &round.Name, &round.Capacity, &round.Status,
+		&round.Settings.Scorer, &round.Settings.GenreWeight, &round.Settings.Mode, &round.Settings.Objective,
+		&round.Settings.GroupSize, &round.Settings.MinScore, &round.Settings.Candidates, &round.Settings.Sides, &round.CreatedAt, &round.OpenedAt, &round.ClosedAt, &round.MatchedAt)
+	return round, err
+}
+
+// sides returns the round's sides for storing, as an empty array rather than
+// NULL when the round has none
+func sides(settings RoundSettings) []string {
+	if settings.Sides == nil {
+		return []string{}
+	}
+	return settings.Sides
+}
+
+// CreateRound creates a new round in the draft state
+func (c *DBClient) CreateRound(ctx context.Context, name string, capacity int, settings RoundSettings) (Round, error) {
+	round, err := scanRound(c.conn.QueryRow(ctx,
+		`INSERT INTO rounds (name, capacity, scorer, genre_weight, mode, objective, group_size, min_score, candidates, sides)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING `+roundColumns,
+		name, capacity, settings.Scorer, settings.GenreWeight, settings.Mode, settings.Objective, settings.GroupSize,
+		settings.MinScore, settings.Candidates, sides(settings)))
+	if err != nil {
+		return Round{}, fmt.Errorf("failed to create round: %w", err)
+	}
+	return round, nil
+}
+
+// UpdateRoundSettings changes how a round will be matched. Only draft and open
+// rounds that have not been matched yet can be changed, so that saved results
+// always match the settings they were made with. The sides can only be changed
+// until the first user joins, since users keep the side they chose.
+func (c *DBClient) UpdateRoundSettings(ctx context.Context, roundID int, settings RoundSettings) (Round, error) {
+	round, err := scanRound(c.conn.QueryRow(ctx,
+		`UPDATE rounds
+		SET scorer = $2, genre_weight = $3, mode = $4, objective = $5, group_size = $6, min_score = $7, candidates = $8,
+			sides = $9
+		WHERE round_id = $1 AND status IN ($10, $11) AND matched_at IS NULL
+			AND (sides = $9 OR NOT EXISTS (SELECT 1 FROM round_users WHERE round_id = $1))
+		RETURNING `+roundColumns,
+		roundID, settings.Scorer, settings.GenreWeight, settings.Mode, settings.Objective, settings.GroupSize,
+		settings.MinScore, settings.Candidates, sides(settings), RoundStatusDraft, RoundStatusOpen))
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Distinguish a missing round from one that can no longer be changed
+		round, err := c.GetRound(ctx, roundID)
+		if err != nil {
+			return Round{}, err
+		}
+		if round.Status == RoundStatusClosed || round.MatchedAt != nil {
+			return Round{}, ErrRoundSettingsLocked
+		}
+		return Round{}, ErrSidesLocked
+	}
+	if err != nil {
+		return Round{}, fmt.Errorf("failed to update round settings: %w", err)
+	}
+	return round, nil
+}
+
+// GetRound returns the round with the given ID
+func (c *DBClient) GetRound(ctx context.Context, roundID int) (Round, error) {
+	round, err := scanRound(c.conn.QueryRow(ctx,
+		`SELECT `+roundColumns+` FROM rounds WHERE round_id = $1`, roundID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Round{}, ErrRoundNotFound
+	}
+	if err != nil {
+		return Round{}, fmt.Errorf("failed to get round: %w", err)
+	}
+	return round, nil
+}
+
+// GetOpenRound returns the round that is currently accepting signups
+func (c *DBClient) GetOpenRound(ctx context.Context) (Round, error) {
+	round, err := scanRound(c.conn.QueryRow(ctx,
+		`SELECT `+roundColumns+` FROM rounds WHERE status = $1`, RoundStatusOpen))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Round{}, ErrNoOpenRound
+	}
+	if err != nil {
+		return Round{}, fmt.Errorf("failed to get open round: %w", err)
+	}
+	return round, nil
+}
+
+// ListRounds returns all rounds, newest first
+func (c *DBClient) ListRounds(ctx context.Context) ([]Round, error) {
+	rows, err := c.conn.Query(ctx,
+		`SELECT `+roundColumns+` FROM rounds ORDER BY created_at DESC, round_id DESC`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rounds: %w", err)
+	}
+	defer rows.Close()
+
+	var rounds []Round
+	for rows.Next() {
+		round, err := scanRound(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan round row: %w", err)
+		}
+		rounds = append(rounds, round)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating round rows: %w", err)
+	}
+
+	return rounds, nil
+}
+
+// OpenRound moves a draft round to the open state and enrolls the users
+// carried over from earlier rounds
+func (c *DBClient) OpenRound(ctx context.Context, roundID int) (Round, error) {
+	// Begin a transaction
+	tx, err := c.conn.Begin(ctx)
+	if err != nil {
+		return Round{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	// Ensure the transaction is rolled back if an error occurs
+	defer tx.Rollback(ctx)
+
+	round, err := c.transitionRound(ctx, tx, roundID, RoundStatusDraft, RoundStatusOpen, "opened_at")
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+		return Round{}, ErrRoundAlreadyOpen
+	}
+	if err != nil {
+		return Round{}, err
+	}
+
+	if err := enrollCarryovers(ctx, tx, round.ID); err != nil {
+		return Round{}, err
+	}
+
+	// Commit the transaction
+	if err = tx.Commit(ctx); err != nil {
+		return Round{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return round, nil
+}
+
+// CloseRound moves an open round to the closed state
+func (c *DBClient) CloseRound(ctx context.Context, roundID int) (Round, error) {
+	return c.transitionRound(ctx, c.conn, roundID, RoundStatusOpen, RoundStatusClosed, "closed_at")
+}
+
+// rowQuerier is implemented by both the connection pool and transactions
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// transitionRound updates a round's status if it is currently in the expected state,
+// stamping the given timestamp column with the time of the transition
+func (c *DBClient) transitionRound(ctx context.Context, q rowQuerier, roundID int, from, to, timestampColumn string) (Round, error) {
+	round, err := scanRound(q.QueryRow(ctx,
+		`UPDATE rounds
+		SET status = $3, `+timestampColumn+` = CURRENT_TIMESTAMP
+		WHERE round_id = $1 AND status = $2
+		RETURNING `+roundColumns,
+		roundID, from, to))
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Distinguish a missing round from one in the wrong state
+		if _, err := c.GetRound(ctx, roundID); err != nil {
+			return Round{}, err
+		}
+		return Round{}, fmt.Errorf("%w: round %d is not %s", ErrInvalidRoundTransition, roundID, from)
+	}
+	if err != nil {
+		return Round{}, fmt.Errorf("failed to update round status: %w", err)
+	}
+	return round, nil
+}
+
+// GetLatestMatchedRound returns the round whose results were saved most recently
+func (c *DBClient) GetLatestMatchedRound(ctx context.Context) (Round, error) {
+	round, err := scanRound(c.conn.QueryRow(ctx,
+		`SELECT `+roundColumns+` FROM rounds
+		WHERE matched_at IS NOT NULL
+		ORDER BY matched_at DESC
+		LIMIT 1`))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Round{}, ErrRoundNotFound
+	}
+	if err != nil {
+		return Round{}, fmt.Errorf("failed to get latest matched round: %w", err)
+	}
+	return round, nil
+}
+
+// GetLatestRound returns the most recently opened round, whether it is still open or closed
+func (c *DBClient) GetLatestRound(ctx context.Context) (Round, error) {
+	round, err := scanRound(c.conn.QueryRow(ctx,
+		`SELECT `+roundColumns+` FROM rounds
+		WHERE opened_at IS NOT NULL
+		ORDER BY opened_at DESC
+		LIMIT 1`))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Round{}, ErrRoundNotFound
+	}
+	if err != nil {
+		return Round{}, fmt.Errorf("failed to get latest round: %w", err)
+	}
+	return round, nil
+}
@@ -0,0 +1,180 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	// ErrSignupNotFound is returned when there is no pending signup, or it has expired
+	ErrSignupNotFound = errors.New("signup not found or expired")
+	// ErrUnknownArtist is returned when a signup selects an artist that is not stored
+	ErrUnknownArtist = errors.New("artist not found")
+)
+
+// PendingSignup is a manual signup waiting for the user to confirm their email
+type PendingSignup struct {
+	ID         int
+	RoundID    int
+	User       UserInfo
+	ArtistIDs  []string
+	LastSentAt time.Time
+	ExpiresAt  time.Time
+}
+
+const pendingSignupColumns = `signup_id, round_id, email, first_name, last_name, COALESCE(phone_number, ''),
+	notification_channels, discovery, side, artist_ids, last_sent_at, expires_at`
+
+func scanPendingSignup(row pgx.Row) (PendingSignup, error) {
+	var s PendingSignup
+	err := row.Scan(&s.ID, &s.RoundID, &s.User.Email, &s.User.FirstName, &s.User.LastName, &s.User.PhoneNumber,
+		&s.User.NotificationChannels, &s.User.Discovery, &s.User.Side, &s.ArtistIDs, &s.LastSentAt, &s.ExpiresAt)
+	return s, err
+}
+
+// CreatePendingSignup stores a manual signup until the user confirms their
+// email, replacing an earlier one with the same email for the round. Expired
+// signups are deleted along the way.
+func (c *DBClient) CreatePendingSignup(ctx context.Context, roundID int, user UserInfo, artistIDs []string, ttl time.Duration) (PendingSignup, error) {
+	tx, err := c.conn.Begin(ctx)
+	if err != nil {
+		return PendingSignup{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM pending_signups WHERE expires_at <= CURRENT_TIMESTAMP`); err != nil {
+		return PendingSignup{}, fmt.Errorf("failed to delete expired signups: %w", err)
+	}
+
+	// Check the artists now, so that confirming the signup can't fail on them
+	var known int
+	err = tx.QueryRow(ctx,
+		`SELECT COUNT(DISTINCT spotify_artist_id) FROM artists WHERE spotify_artist_id = ANY($1)`,
+		artistIDs).Scan(&known)
+	if err != nil {
+		return PendingSignup{}, fmt.Errorf("failed to check artists: %w", err)
+	}
+	distinct := make(map[string]bool, len(artistIDs))
+	for _, id := range artistIDs {
+		distinct[id] = true
+	}
+	if known != len(distinct) {
+		return PendingSignup{}, ErrUnknownArtist
+	}
+
+	s, err := scanPendingSignup(tx.QueryRow(ctx,
+		`INSERT INTO pending_signups (round_id, email, first_name, last_name, phone_number, notification_channels,
+			discovery, side, artist_ids, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, CURRENT_TIMESTAMP + $10 * INTERVAL '1 millisecond')
+		ON CONFLICT (email, round_id) DO UPDATE
+		SET first_name = EXCLUDED.first_name, last_name = EXCLUDED.last_name, phone_number = EXCLUDED.phone_number,
+			notification_channels = EXCLUDED.notification_channels, discovery = EXCLUDED.discovery,
+			side = EXCLUDED.side, artist_ids = EXCLUDED.artist_ids, last_sent_at = CURRENT_TIMESTAMP,
+			expires_at = EXCLUDED.expires_at
+		RETURNING `+pendingSignupColumns,
+		roundID, user.Email, user.FirstName, user.LastName, user.PhoneNumber, user.NotificationChannels,
+		user.Discovery, user.Side, artistIDs, ttl.Milliseconds()))
+	if err != nil {
+		return PendingSignup{}, fmt.Errorf("failed to save pending signup: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return PendingSignup{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return s, nil
+}
+
+// GetPendingSignup returns the unexpired pending signup with the given ID
+func (c *DBClient) GetPendingSignup(ctx context.Context, signupID int) (PendingSignup, error) {
+	s, err := scanPendingSignup(c.conn.QueryRow(ctx,
+		`SELECT `+pendingSignupColumns+` FROM pending_signups
+		WHERE signup_id = $1 AND expires_at > CURRENT_TIMESTAMP`,
+		signupID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return PendingSignup{}, ErrSignupNotFound
+	}
+	if err != nil {
+		return PendingSignup{}, fmt.Errorf("failed to get pending signup: %w", err)
+	}
+	return s, nil
+}
+
+// GetLatestPendingSignup returns the most recent unexpired pending signup for an email
+func (c *DBClient) GetLatestPendingSignup(ctx context.Context, email string) (PendingSignup, error) {
+	s, err := scanPendingSignup(c.conn.QueryRow(ctx,
+		`SELECT `+pendingSignupColumns+` FROM pending_signups
+		WHERE email = $1 AND expires_at > CURRENT_TIMESTAMP
+		ORDER BY created_at DESC, signup_id DESC
+		LIMIT 1`,
+		email))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return PendingSignup{}, ErrSignupNotFound
+	}
+	if err != nil {
+		return PendingSignup{}, fmt.Errorf("failed to get pending signup: %w", err)
+	}
+	return s, nil
+}
+
+// RenewPendingSignup records that the verification email was sent again and
+// gives the signup a new expiry
+func (c *DBClient) RenewPendingSignup(ctx context.Context, signupID int, ttl time.Duration) (PendingSignup, error) {
+	s, err := scanPendingSignup(c.conn.QueryRow(ctx,
+		`UPDATE pending_signups
+		SET last_sent_at = CURRENT_TIMESTAMP, expires_at = CURRENT_TIMESTAMP + $2 * INTERVAL '1 millisecond'
+		WHERE signup_id = $1 AND expires_at > CURRENT_TIMESTAMP
+		RETURNING `+pendingSignupColumns,
+		signupID, ttl.Milliseconds()))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return PendingSignup{}, ErrSignupNotFound
+	}
+	if err != nil {
+		return PendingSignup{}, fmt.Errorf("failed to renew pending signup: %w", err)
+	}
+	return s, nil
+}
+
+// ConfirmSignup creates the user of an unexpired pending signup with their
+// email marked as verified, enrolls them in the round with their selected
+// artists and removes the pending signup, all in one transaction. It returns
+// the user ID and the selected artists.
+func (c *DBClient) ConfirmSignup(ctx context.Context, signupID int) (string, []Artist, error) {
+	tx, err := c.conn.Begin(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the signup so that clicking the link twice at once confirms it once
+	s, err := scanPendingSignup(tx.QueryRow(ctx,
+		`SELECT `+pendingSignupColumns+` FROM pending_signups
+		WHERE signup_id = $1 AND expires_at > CURRENT_TIMESTAMP
+		FOR UPDATE`,
+		signupID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil, ErrSignupNotFound
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get pending signup: %w", err)
+	}
+
+	user := s.User
+	user.EmailVerified = true
+	userID, artists, err := saveUserSelectedArtists(ctx, tx, s.RoundID, user, s.ArtistIDs)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM pending_signups WHERE signup_id = $1`, signupID); err != nil {
+		return "", nil, fmt.Errorf("failed to delete pending signup: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return userID, artists, nil
+}
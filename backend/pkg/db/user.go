@@ -2,17 +2,349 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var (
+	// ErrUserNotFound is returned when a user does not exist
+	ErrUserNotFound = errors.New("user not found")
+	// ErrEmailTaken is returned when saving a user with the email of another
+	// user, without having verified that the email is theirs
+	ErrEmailTaken = errors.New("a user with this email already exists")
+	// ErrSpotifyLinked is returned when connecting Spotify with the email of a
+	// user who is linked to a different Spotify account
+	ErrSpotifyLinked = errors.New("this email is linked to a different Spotify account")
 )
 
-// GetUserCount returns the total number of users in the database
-func (c *DBClient) GetUserCount(ctx context.Context) (int, error) {
+// GetRoundUserCount returns the number of users enrolled in the given round
+func (c *DBClient) GetRoundUserCount(ctx context.Context, roundID int) (int, error) {
 	var count int
 
-	err := c.conn.QueryRow(ctx, "SELECT COUNT(*) FROM users").Scan(&count)
+	err := c.conn.QueryRow(ctx, "SELECT COUNT(*) FROM round_users WHERE round_id = $1", roundID).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get user count: %w", err)
 	}
 
 	return count, nil
 }
+
+// upsertUser saves a user within a transaction and returns their ID. Returning
+// users are recognized by their Spotify ID, or by their email once it has been
+// verified, and have their details replaced. Verifying the email of a user
+// without a Spotify account while connecting Spotify links the two.
+func upsertUser(ctx context.Context, tx pgx.Tx, user UserInfo) (string, error) {
+	var userID string
+	if user.SpotifyUserID != "" {
+		err := tx.QueryRow(ctx,
+			`SELECT user_id FROM users WHERE spotify_user_id = $1 FOR UPDATE`,
+			user.SpotifyUserID).Scan(&userID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("failed to get user by Spotify ID: %w", err)
+		}
+	}
+
+	var emailUserID, emailSpotifyID string
+	err := tx.QueryRow(ctx,
+		`SELECT user_id, COALESCE(spotify_user_id, '') FROM users WHERE email = $1 FOR UPDATE`,
+		user.Email).Scan(&emailUserID, &emailSpotifyID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return "", fmt.Errorf("failed to get user by email: %w", err)
+	}
+
+	switch {
+	case emailUserID == "" || emailUserID == userID:
+		// The email is free or already theirs
+	case userID != "" || !user.EmailVerified:
+		return "", ErrEmailTaken
+	case user.SpotifyUserID != "" && emailSpotifyID != "":
+		return "", ErrSpotifyLinked
+	default:
+		userID = emailUserID
+	}
+
+	if userID == "" {
+		err := tx.QueryRow(ctx,
+			`INSERT INTO users (first_name, last_name, email, phone_number, spotify_user_id, notification_channels,
+				email_verified_at)
+			VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, CASE WHEN $7 THEN CURRENT_TIMESTAMP END)
+			RETURNING user_id`,
+			user.FirstName, user.LastName, user.Email, user.PhoneNumber, user.SpotifyUserID,
+			user.NotificationChannels, user.EmailVerified).Scan(&userID)
+		// Someone else signed up with the email or Spotify ID in the meantime
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return "", ErrEmailTaken
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to insert user: %w", err)
+		}
+		return userID, nil
+	}
+
+	// A changed email stays verified only if the new one was verified too
+	_, err = tx.Exec(ctx,
+		`UPDATE users
+		SET first_name = $2, last_name = $3, email = $4, phone_number = $5,
+			spotify_user_id = COALESCE(NULLIF($6, ''), spotify_user_id), notification_channels = $7,
+			email_verified_at = CASE
+				WHEN email = $4 AND email_verified_at IS NOT NULL THEN email_verified_at
+				WHEN $8 THEN CURRENT_TIMESTAMP
+			END
+		WHERE user_id = $1`,
+		userID, user.FirstName, user.LastName, user.Email, user.PhoneNumber, user.SpotifyUserID,
+		user.NotificationChannels, user.EmailVerified)
+	if err != nil {
+		return "", fmt.Errorf("failed to update user: %w", err)
+	}
+	return userID, nil
+}
+
+// IsEmailEnrolled reports whether the user with the given email is part of the round
+func (c *DBClient) IsEmailEnrolled(ctx context.Context, roundID int, email string) (bool, error) {
+	var enrolled bool
+	err := c.conn.QueryRow(ctx,
+		`SELECT EXISTS (
+			SELECT 1 FROM round_users ru JOIN users u ON u.user_id = ru.user_id
+			WHERE ru.round_id = $1 AND u.email = $2
+		)`,
+		roundID, email).Scan(&enrolled)
+	if err != nil {
+		return false, fmt.Errorf("failed to check enrollment: %w", err)
+	}
+	return enrolled, nil
+}
+
+// enrollUser adds the user to the round if they are not already part of it,
+// recording whether they opted in to discovery matching and their side. The
+// round row is locked while its users are counted, so concurrent signups can't
+// take it past its capacity; users already in the round don't count again.
+// Rounds that were already matched are treated as closed.
+func enrollUser(ctx context.Context, tx pgx.Tx, roundID int, userID string, user UserInfo) error {
+	var status string
+	var capacity int
+	var matched bool
+	err := tx.QueryRow(ctx,
+		`SELECT status, capacity, matched_at IS NOT NULL FROM rounds WHERE round_id = $1 FOR UPDATE`,
+		roundID).Scan(&status, &capacity, &matched)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrRoundNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock round: %w", err)
+	}
+	if status != RoundStatusOpen || matched {
+		return ErrRoundClosed
+	}
+
+	var userCount int
+	var enrolled bool
+	err = tx.QueryRow(ctx,
+		`SELECT COUNT(*), COUNT(*) FILTER (WHERE user_id = $2) > 0 FROM round_users WHERE round_id = $1`,
+		roundID, userID).Scan(&userCount, &enrolled)
+	if err != nil {
+		return fmt.Errorf("failed to count round users: %w", err)
+	}
+	if !enrolled && userCount >= capacity {
+		return ErrRoundFull
+	}
+
+	_, err = tx.Exec(ctx,
+		`INSERT INTO round_users (round_id, user_id, discovery, side)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (round_id, user_id) DO UPDATE SET discovery = EXCLUDED.discovery, side = EXCLUDED.side`,
+		roundID, userID, user.Discovery, user.Side)
+	if err != nil {
+		return fmt.Errorf("failed to enroll user in round: %w", err)
+	}
+	return nil
+}
+
+// User represents a stored user along with their contact details
+type User struct {
+	ID          int
+	FirstName   string
+	LastName    string
+	Email       string
+	PhoneNumber string
+	// NotificationChannels are the channels the user wants to be notified on
+	NotificationChannels []string
+}
+
+const userColumns = `u.user_id, u.first_name, u.last_name, u.email, COALESCE(u.phone_number, ''),
+	u.notification_channels`
+
+func scanUser(row pgx.Row) (User, error) {
+	var user User
+	err := row.Scan(&user.ID, &user.FirstName, &user.LastName, &user.Email, &user.PhoneNumber,
+		&user.NotificationChannels)
+	return user, err
+}
+
+// GetUser returns the user with the given ID
+func (c *DBClient) GetUser(ctx context.Context, userID int) (User, error) {
+	user, err := scanUser(c.conn.QueryRow(ctx,
+		`SELECT `+userColumns+` FROM users u WHERE u.user_id = $1`, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return User{}, ErrUserNotFound
+	}
+	if err != nil {
+		return User{}, fmt.Errorf("failed to get user: %w", err)
+	}
+	return user, nil
+}
+
+// UpdateUser changes a user's name, phone number and notification channels
+func (c *DBClient) UpdateUser(ctx context.Context, user User) (User, error) {
+	updated, err := scanUser(c.conn.QueryRow(ctx,
+		`UPDATE users u
+		SET first_name = $2, last_name = $3, phone_number = $4, notification_channels = $5
+		WHERE u.user_id = $1
+		RETURNING `+userColumns,
+		user.ID, user.FirstName, user.LastName, user.PhoneNumber, user.NotificationChannels))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return User{}, ErrUserNotFound
+	}
+	if err != nil {
+		return User{}, fmt.Errorf("failed to update user: %w", err)
+	}
+	return updated, nil
+}
+
+// GetUserLatestRoundID returns the most recent round the user joined
+func (c *DBClient) GetUserLatestRoundID(ctx context.Context, userID int) (int, error) {
+	var roundID int
+	err := c.conn.QueryRow(ctx,
+		`SELECT round_id FROM round_users
+		WHERE user_id = $1
+		ORDER BY joined_at DESC, round_id DESC
+		LIMIT 1`,
+		userID).Scan(&roundID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, ErrRoundNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get user's latest round: %w", err)
+	}
+	return roundID, nil
+}
+
+// GetRoundUsers returns all users enrolled in the given round
+func (c *DBClient) GetRoundUsers(ctx context.Context, roundID int) ([]User, error) {
+	rows, err := c.conn.Query(ctx,
+		`SELECT `+userColumns+`
+		FROM users u
+		JOIN round_users ru ON u.user_id = ru.user_id
+		WHERE ru.round_id = $1
+		ORDER BY u.user_id`,
+		roundID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query round users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan user row: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating user rows: %w", err)
+	}
+
+	return users, nil
+}
+
+// GetDiscoveryUserIDs returns the users of the round who opted in to being
+// matched with someone who can broaden their listening
+func (c *DBClient) GetDiscoveryUserIDs(ctx context.Context, roundID int) (map[int]bool, error) {
+	rows, err := c.conn.Query(ctx,
+		`SELECT user_id FROM round_users
+		WHERE round_id = $1 AND discovery`,
+		roundID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query discovery users: %w", err)
+	}
+	defer rows.Close()
+
+	userIDs := make(map[int]bool)
+	for rows.Next() {
+		var userID int
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("failed to scan discovery user row: %w", err)
+		}
+		userIDs[userID] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating discovery user rows: %w", err)
+	}
+
+	return userIDs, nil
+}
+
+// GetUserSide returns the side the user chose for the round, empty if they have
+// not chosen one
+func (c *DBClient) GetUserSide(ctx context.Context, roundID, userID int) (string, error) {
+	var side string
+	err := c.conn.QueryRow(ctx,
+		`SELECT side FROM round_users WHERE round_id = $1 AND user_id = $2`,
+		roundID, userID).Scan(&side)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrNotEnrolled
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get user side: %w", err)
+	}
+	return side, nil
+}
+
+// UpdateUserSide changes the side the user chose for the round
+func (c *DBClient) UpdateUserSide(ctx context.Context, roundID, userID int, side string) error {
+	tag, err := c.conn.Exec(ctx,
+		`UPDATE round_users SET side = $3 WHERE round_id = $1 AND user_id = $2`,
+		roundID, userID, side)
+	if err != nil {
+		return fmt.Errorf("failed to update user side: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotEnrolled
+	}
+	return nil
+}
+
+// GetUserSides returns the side each user of the round chose at signup, for
+// users who chose one
+func (c *DBClient) GetUserSides(ctx context.Context, roundID int) (map[int]string, error) {
+	rows, err := c.conn.Query(ctx,
+		`SELECT user_id, side FROM round_users
+		WHERE round_id = $1 AND side <> ''`,
+		roundID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user sides: %w", err)
+	}
+	defer rows.Close()
+
+	sides := make(map[int]string)
+	for rows.Next() {
+		var userID int
+		var side string
+		if err := rows.Scan(&userID, &side); err != nil {
+			return nil, fmt.Errorf("failed to scan user side row: %w", err)
+		}
+		sides[userID] = side
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating user side rows: %w", err)
+	}
+
+	return sides, nil
+}
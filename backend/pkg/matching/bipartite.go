@@ -0,0 +1,60 @@
+package matching
+
+// SideCount summarizes how the members of one side of the round were matched
+type SideCount struct {
+	Side    string
+	Members int
+	Matched int
+}
+
+// side returns the position of the member's side in the round's sides, or -1
+// if they did not choose one of them
+func (opts Options) side(m *Member) int {
+	for k, side := range opts.Sides {
+		if m.Side == side {
+			return k
+		}
+	}
+	return -1
+}
+
+// pairable reports whether two members may be matched at all. In bipartite
+// mode only members on opposite sides of the round may be.
+func (opts Options) pairable(a, b *Member) bool {
+	if opts.Mode != ModeBipartite {
+		return true
+	}
+	sa, sb := opts.side(a), opts.side(b)
+	return sa != -1 && sb != -1 && sa != sb
+}
+
+// MatchBipartite pairs every member with someone from the other side of the
+// round, maximizing the total similarity as in MatchPairs. Members without one
+// of the round's sides are left unmatched.
+//
+// When the sides are uneven at most as many pairs as the smaller side has
+// members can be formed, and the rest of the larger side is left unmatched
+// and carried over to the next round. Members carried over from an earlier
+// round are matched first, so the same members are not left out twice. The
+// result counts each side's members and how many were matched.
+func MatchBipartite(c *Cohort, opts Options) *Result {
+	result := MatchPairs(c, opts)
+
+	result.Sides = make([]SideCount, len(opts.Sides))
+	for k, side := range opts.Sides {
+		result.Sides[k].Side = side
+	}
+	for _, m := range c.Members {
+		if k := opts.side(m); k != -1 {
+			result.Sides[k].Members++
+		} else {
+			result.Unsided = append(result.Unsided, m)
+		}
+	}
+	for _, p := range result.Pairs {
+		for _, m := range []*Member{p.A, p.B} {
+			result.Sides[opts.side(m)].Matched++
+		}
+	}
+	return result
+}
@@ -0,0 +1,694 @@
+package matching
+
+// This file implements Edmonds' blossom algorithm for maximum weight matching in
+// general graphs. It follows Joris van Rantwijk's O(n^3) formulation (the same one
+// used by NetworkX and rustworkx). Weights are integers so that every dual update
+// is exact; callers scale their float similarities with weightScale.
+
+// Edge is an undirected weighted edge between two vertices
+type Edge struct {
+	U, V   int
+	Weight int64
+}
+
+// MaxWeightMatching computes a maximum weight matching of the graph with n vertices.
+// If maxCardinality is true, only maximum-cardinality matchings are considered.
+// It returns mate, where mate[v] is the vertex matched to v or -1 if v is unmatched.
+func MaxWeightMatching(n int, edges []Edge, maxCardinality bool) []int {
+	mate := make([]int, n)
+	for i := range mate {
+		mate[i] = -1
+	}
+	if len(edges) == 0 || n == 0 {
+		return mate
+	}
+
+	m := newBlossomMatcher(n, edges, maxCardinality)
+	m.solve()
+
+	for v := 0; v < n; v++ {
+		if m.mate[v] >= 0 {
+			mate[v] = m.endpoint[m.mate[v]]
+		}
+	}
+	return mate
+}
+
+type blossomMatcher struct {
+	nvertex        int
+	edges          []Edge
+	maxCardinality bool
+
+	// endpoint[p] is the vertex at endpoint p; edge k has endpoints 2k and 2k+1
+	endpoint []int
+	// neighbend[v] lists the remote endpoints of the edges incident to v
+	neighbend [][]int
+	// mate[v] is the remote endpoint of v's matched edge, or -1
+	mate []int
+
+	// Labels are stored per top-level blossom and per vertex:
+	// 0 = unlabeled, 1 = S-vertex/blossom, 2 = T-vertex/blossom
+	label    []int
+	labelend []int
+
+	inblossom        []int
+	blossomparent    []int
+	blossomchilds    [][]int
+	blossombase      []int
+	blossomendps     [][]int
+	bestedge         []int
+	blossombestedges [][]int
+	unusedblossoms   []int
+	dualvar          []int64
+	allowedge        []bool
+	queue            []int
+}
+
+func newBlossomMatcher(n int, edges []Edge, maxCardinality bool) *blossomMatcher {
+	m := &blossomMatcher{
+		nvertex:        n,
+		edges:          edges,
+		maxCardinality: maxCardinality,
+	}
+
+	var maxWeight int64
+	for _, e := range edges {
+		if e.Weight > maxWeight {
+			maxWeight = e.Weight
+		}
+	}
+
+	m.endpoint = make([]int, 2*len(edges))
+	m.neighbend = make([][]int, n)
+	for k, e := range edges {
+		m.endpoint[2*k] = e.U
+		m.endpoint[2*k+1] = e.V
+		m.neighbend[e.U] = append(m.neighbend[e.U], 2*k+1)
+		m.neighbend[e.V] = append(m.neighbend[e.V], 2*k)
+	}
+
+	m.mate = filled(n, -1)
+	m.label = make([]int, 2*n)
+	m.labelend = filled(2*n, -1)
+	m.inblossom = make([]int, n)
+	for i := range m.inblossom {
+		m.inblossom[i] = i
+	}
+	m.blossomparent = filled(2*n, -1)
+	m.blossomchilds = make([][]int, 2*n)
+	m.blossombase = filled(2*n, -1)
+	for i := 0; i < n; i++ {
+		m.blossombase[i] = i
+	}
+	m.blossomendps = make([][]int, 2*n)
+	m.bestedge = filled(2*n, -1)
+	m.blossombestedges = make([][]int, 2*n)
+	for b := 2*n - 1; b >= n; b-- {
+		m.unusedblossoms = append(m.unusedblossoms, b)
+	}
+	m.dualvar = make([]int64, 2*n)
+	for i := 0; i < n; i++ {
+		m.dualvar[i] = maxWeight
+	}
+	m.allowedge = make([]bool, len(edges))
+
+	return m
+}
+
+func filled(n, value int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = value
+	}
+	return s
+}
+
+// slack returns 2 * slack of edge k (does not work inside blossoms)
+func (m *blossomMatcher) slack(k int) int64 {
+	e := m.edges[k]
+	return m.dualvar[e.U] + m.dualvar[e.V] - 2*e.Weight
+}
+
+// blossomLeaves returns the vertices contained in blossom b
+func (m *blossomMatcher) blossomLeaves(b int) []int {
+	if b < m.nvertex {
+		return []int{b}
+	}
+	var leaves []int
+	for _, t := range m.blossomchilds[b] {
+		leaves = append(leaves, m.blossomLeaves(t)...)
+	}
+	return leaves
+}
+
+// assignLabel labels vertex w and its top-level blossom with t, reached through endpoint p
+func (m *blossomMatcher) assignLabel(w, t, p int) {
+	b := m.inblossom[w]
+	m.label[w], m.label[b] = t, t
+	m.labelend[w], m.labelend[b] = p, p
+	m.bestedge[w], m.bestedge[b] = -1, -1
+	if t == 1 {
+		// b became an S-blossom; scan its vertices
+		m.queue = append(m.queue, m.blossomLeaves(b)...)
+	} else if t == 2 {
+		// b became a T-blossom; label its mate as S
+		base := m.blossombase[b]
+		m.assignLabel(m.endpoint[m.mate[base]], 1, m.mate[base]^1)
+	}
+}
+
+// scanBlossom traces back from v and w to find a new blossom or an augmenting path.
+// It returns the base vertex of the new blossom, or -1 for an augmenting path.
+func (m *blossomMatcher) scanBlossom(v, w int) int {
+	var path []int
+	base := -1
+	for v != -1 || w != -1 {
+		b := m.inblossom[v]
+		if m.label[b]&4 != 0 {
+			base = m.blossombase[b]
+			break
+		}
+		path = append(path, b)
+		m.label[b] = 5
+		if m.labelend[b] == -1 {
+			// Reached a single vertex; stop tracing this path
+			v = -1
+		} else {
+			v = m.endpoint[m.labelend[b]]
+			b = m.inblossom[v]
+			v = m.endpoint[m.labelend[b]]
+		}
+		// Alternate between the two paths
+		if w != -1 {
+			v, w = w, v
+		}
+	}
+	for _, b := range path {
+		m.label[b] = 1
+	}
+	return base
+}
+
+// addBlossom constructs a new blossom with the given base, through S-vertices
+// connected by edge k
+func (m *blossomMatcher) addBlossom(base, k int) {
+	v, w := m.edges[k].U, m.edges[k].V
+	bb := m.inblossom[base]
+	bv := m.inblossom[v]
+	bw := m.inblossom[w]
+
+	b := m.unusedblossoms[len(m.unusedblossoms)-1]
+	m.unusedblossoms = m.unusedblossoms[:len(m.unusedblossoms)-1]
+	m.blossombase[b] = base
+	m.blossomparent[b] = -1
+	m.blossomparent[bb] = b
+
+	// Trace back from v to base
+	var path, endps []int
+	for bv != bb {
+		m.blossomparent[bv] = b
+		path = append(path, bv)
+		endps = append(endps, m.labelend[bv])
+		v = m.endpoint[m.labelend[bv]]
+		bv = m.inblossom[v]
+	}
+	path = append(path, bb)
+	reverseInts(path)
+	reverseInts(endps)
+	endps = append(endps, 2*k)
+
+	// Trace back from w to base
+	for bw != bb {
+		m.blossomparent[bw] = b
+		path = append(path, bw)
+		endps = append(endps, m.labelend[bw]^1)
+		w = m.endpoint[m.labelend[bw]]
+		bw = m.inblossom[w]
+	}
+	m.blossomchilds[b] = path
+	m.blossomendps[b] = endps
+
+	// The new blossom is an S-blossom
+	m.label[b] = 1
+	m.labelend[b] = m.labelend[bb]
+	m.dualvar[b] = 0
+
+	// Relabel vertices; former T-vertices need to be scanned
+	for _, leaf := range m.blossomLeaves(b) {
+		if m.label[m.inblossom[leaf]] == 2 {
+			m.queue = append(m.queue, leaf)
+		}
+		m.inblossom[leaf] = b
+	}
+
+	// Compute the least-slack edges to neighbouring S-blossoms
+	bestedgeto := filled(2*m.nvertex, -1)
+	for _, child := range path {
+		var nblists [][]int
+		if m.blossombestedges[child] == nil {
+			for _, leaf := range m.blossomLeaves(child) {
+				nblist := make([]int, len(m.neighbend[leaf]))
+				for i, p := range m.neighbend[leaf] {
+					nblist[i] = p / 2
+				}
+				nblists = append(nblists, nblist)
+			}
+		} else {
+			nblists = [][]int{m.blossombestedges[child]}
+		}
+		for _, nblist := range nblists {
+			for _, ek := range nblist {
+				// Look at the endpoint outside the new blossom
+				j := m.edges[ek].V
+				if m.inblossom[j] == b {
+					j = m.edges[ek].U
+				}
+				bj := m.inblossom[j]
+				if bj != b && m.label[bj] == 1 &&
+					(bestedgeto[bj] == -1 || m.slack(ek) < m.slack(bestedgeto[bj])) {
+					bestedgeto[bj] = ek
+				}
+			}
+		}
+		m.blossombestedges[child] = nil
+		m.bestedge[child] = -1
+	}
+
+	best := []int{}
+	for _, ek := range bestedgeto {
+		if ek != -1 {
+			best = append(best, ek)
+		}
+	}
+	m.blossombestedges[b] = best
+	m.bestedge[b] = -1
+	for _, ek := range best {
+		if m.bestedge[b] == -1 || m.slack(ek) < m.slack(m.bestedge[b]) {
+			m.bestedge[b] = ek
+		}
+	}
+}
+
+// expandBlossom dissolves blossom b, relabelling its children if needed
+func (m *blossomMatcher) expandBlossom(b int, endstage bool) {
+	// Convert sub-blossoms into top-level blossoms
+	for _, s := range m.blossomchilds[b] {
+		m.blossomparent[s] = -1
+		if s < m.nvertex {
+			m.inblossom[s] = s
+		} else if endstage && m.dualvar[s] == 0 {
+			// Recursively expand this sub-blossom
+			m.expandBlossom(s, endstage)
+		} else {
+			for _, leaf := range m.blossomLeaves(s) {
+				m.inblossom[leaf] = s
+			}
+		}
+	}
+
+	// If we expand a T-blossom during a stage, its sub-blossoms must be relabeled
+	if !endstage && m.label[b] == 2 {
+		childs := m.blossomchilds[b]
+		endps := m.blossomendps[b]
+		entrychild := m.inblossom[m.endpoint[m.labelend[b]^1]]
+		j := indexOf(childs, entrychild)
+		var jstep, endptrick int
+		if j&1 != 0 {
+			// Start index is odd; go forward and wrap
+			j -= len(childs)
+			jstep = 1
+			endptrick = 0
+		} else {
+			// Start index is even; go backward
+			jstep = -1
+			endptrick = 1
+		}
+
+		// Move along the blossom until we get to the base
+		p := m.labelend[b]
+		for j != 0 {
+			// Relabel the T-sub-blossom
+			m.label[m.endpoint[p^1]] = 0
+			m.label[m.endpoint[at(endps, j-endptrick)^endptrick^1]] = 0
+			m.assignLabel(m.endpoint[p^1], 2, p)
+			// Step to the next S-sub-blossom and note its forward endpoint
+			m.allowedge[at(endps, j-endptrick)/2] = true
+			j += jstep
+			p = at(endps, j-endptrick) ^ endptrick
+			// Step to the next T-sub-blossom
+			m.allowedge[p/2] = true
+			j += jstep
+		}
+
+		// Relabel the base T-sub-blossom without stepping through to its mate
+		bv := at(childs, j)
+		m.label[m.endpoint[p^1]], m.label[bv] = 2, 2
+		m.labelend[m.endpoint[p^1]], m.labelend[bv] = p, p
+		m.bestedge[bv] = -1
+
+		// Continue along the blossom until we get back to entrychild
+		j += jstep
+		for at(childs, j) != entrychild {
+			bv = at(childs, j)
+			if m.label[bv] == 1 {
+				// This sub-blossom just got label S through one of its neighbours
+				j += jstep
+				continue
+			}
+			// If the sub-blossom contains a reachable vertex, assign label T to it
+			reached := -1
+			for _, leaf := range m.blossomLeaves(bv) {
+				if m.label[leaf] != 0 {
+					reached = leaf
+					break
+				}
+			}
+			if reached != -1 {
+				m.label[reached] = 0
+				m.label[m.endpoint[m.mate[m.blossombase[bv]]]] = 0
+				m.assignLabel(reached, 2, m.labelend[reached])
+			}
+			j += jstep
+		}
+	}
+
+	// Recycle the blossom number
+	m.label[b], m.labelend[b] = -1, -1
+	m.blossomchilds[b], m.blossomendps[b] = nil, nil
+	m.blossombase[b] = -1
+	m.blossombestedges[b] = nil
+	m.bestedge[b] = -1
+	m.unusedblossoms = append(m.unusedblossoms, b)
+}
+
+// augmentBlossom swaps matched/unmatched edges over an alternating path through
+// blossom b between vertex v and the base vertex
+func (m *blossomMatcher) augmentBlossom(b, v int) {
+	// Bubble up through the blossom tree from v to an immediate sub-blossom of b
+	t := v
+	for m.blossomparent[t] != b {
+		t = m.blossomparent[t]
+	}
+	// Recursively deal with the first sub-blossom
+	if t >= m.nvertex {
+		m.augmentBlossom(t, v)
+	}
+
+	childs := m.blossomchilds[b]
+	endps := m.blossomendps[b]
+	i := indexOf(childs, t)
+	j := i
+	var jstep, endptrick int
+	if i&1 != 0 {
+		j -= len(childs)
+		jstep = 1
+		endptrick = 0
+	} else {
+		jstep = -1
+		endptrick = 1
+	}
+
+	// Move along the blossom until we get to the base
+	for j != 0 {
+		j += jstep
+		t = at(childs, j)
+		p := at(endps, j-endptrick) ^ endptrick
+		if t >= m.nvertex {
+			m.augmentBlossom(t, m.endpoint[p])
+		}
+		j += jstep
+		t = at(childs, j)
+		if t >= m.nvertex {
+			m.augmentBlossom(t, m.endpoint[p^1])
+		}
+		// Match the edge connecting those sub-blossoms
+		m.mate[m.endpoint[p]] = p ^ 1
+		m.mate[m.endpoint[p^1]] = p
+	}
+
+	// Rotate the list of sub-blossoms to put the new base at the front
+	m.blossomchilds[b] = append(append([]int{}, childs[i:]...), childs[:i]...)
+	m.blossomendps[b] = append(append([]int{}, endps[i:]...), endps[:i]...)
+	m.blossombase[b] = m.blossombase[m.blossomchilds[b][0]]
+}
+
+// augmentMatching swaps matched/unmatched edges over the augmenting path through edge k
+func (m *blossomMatcher) augmentMatching(k int) {
+	v, w := m.edges[k].U, m.edges[k].V
+	for _, start := range [2][2]int{{v, 2*k + 1}, {w, 2 * k}} {
+		s, p := start[0], start[1]
+		// Match vertex s to remote endpoint p, then trace back to a single vertex
+		for {
+			bs := m.inblossom[s]
+			if bs >= m.nvertex {
+				m.augmentBlossom(bs, s)
+			}
+			m.mate[s] = p
+			if m.labelend[bs] == -1 {
+				// Reached a single vertex; stop
+				break
+			}
+			t := m.endpoint[m.labelend[bs]]
+			bt := m.inblossom[t]
+			// Trace one step back
+			s = m.endpoint[m.labelend[bt]]
+			j := m.endpoint[m.labelend[bt]^1]
+			if bt >= m.nvertex {
+				m.augmentBlossom(bt, j)
+			}
+			m.mate[j] = m.labelend[bt]
+			p = m.labelend[bt] ^ 1
+		}
+	}
+}
+
+func (m *blossomMatcher) solve() {
+	n := m.nvertex
+
+	// Each stage finds an augmenting path and uses it to improve the matching
+	for stage := 0; stage < n; stage++ {
+		for i := range m.label {
+			m.label[i] = 0
+			m.bestedge[i] = -1
+		}
+		for b := n; b < 2*n; b++ {
+			m.blossombestedges[b] = nil
+		}
+		for k := range m.allowedge {
+			m.allowedge[k] = false
+		}
+		m.queue = m.queue[:0]
+
+		// Label single blossoms/vertices with S and put them in the queue
+		for v := 0; v < n; v++ {
+			if m.mate[v] == -1 && m.label[m.inblossom[v]] == 0 {
+				m.assignLabel(v, 1, -1)
+			}
+		}
+
+		augmented := false
+		for {
+			// Continue labeling until all vertices which are reachable through an
+			// alternating path have got a label
+			for len(m.queue) > 0 && !augmented {
+				v := m.queue[len(m.queue)-1]
+				m.queue = m.queue[:len(m.queue)-1]
+
+				for _, p := range m.neighbend[v] {
+					k := p / 2
+					w := m.endpoint[p]
+					if m.inblossom[v] == m.inblossom[w] {
+						// This edge is internal to a blossom; ignore it
+						continue
+					}
+					var kslack int64
+					if !m.allowedge[k] {
+						kslack = m.slack(k)
+						if kslack <= 0 {
+							// Edge k has zero slack and is allowable
+							m.allowedge[k] = true
+						}
+					}
+					if m.allowedge[k] {
+						if m.label[m.inblossom[w]] == 0 {
+							// w is a free vertex (or an unreached vertex inside a
+							// T-blossom); label it with T
+							m.assignLabel(w, 2, p^1)
+						} else if m.label[m.inblossom[w]] == 1 {
+							// Found an edge between two S-blossoms
+							base := m.scanBlossom(v, w)
+							if base >= 0 {
+								m.addBlossom(base, k)
+							} else {
+								m.augmentMatching(k)
+								augmented = true
+								break
+							}
+						} else if m.label[w] == 0 {
+							// w is inside a T-blossom but has not been reached yet
+							m.label[w] = 2
+							m.labelend[w] = p ^ 1
+						}
+					} else if m.label[m.inblossom[w]] == 1 {
+						// Keep track of the least-slack non-allowable edge to a different S-blossom
+						b := m.inblossom[v]
+						if m.bestedge[b] == -1 || kslack < m.slack(m.bestedge[b]) {
+							m.bestedge[b] = k
+						}
+					} else if m.label[w] == 0 {
+						// w is a free vertex (or an unreached vertex inside a T-blossom)
+						if m.bestedge[w] == -1 || kslack < m.slack(m.bestedge[w]) {
+							m.bestedge[w] = k
+						}
+					}
+				}
+			}
+
+			if augmented {
+				break
+			}
+
+			// There is no augmenting path under these constraints; compute the dual
+			// adjustment delta and its type
+			deltatype := -1
+			var delta int64
+			deltaedge, deltablossom := -1, -1
+
+			// Delta 1: the minimum value of any vertex dual
+			if !m.maxCardinality {
+				deltatype = 1
+				delta = minInt64(m.dualvar[:n])
+			}
+
+			// Delta 2: the minimum slack on any edge between an S-vertex and a free vertex
+			for v := 0; v < n; v++ {
+				if m.label[m.inblossom[v]] == 0 && m.bestedge[v] != -1 {
+					d := m.slack(m.bestedge[v])
+					if deltatype == -1 || d < delta {
+						delta = d
+						deltatype = 2
+						deltaedge = m.bestedge[v]
+					}
+				}
+			}
+
+			// Delta 3: half the minimum slack on any edge between a pair of S-blossoms
+			for b := 0; b < 2*n; b++ {
+				if m.blossomparent[b] == -1 && m.label[b] == 1 && m.bestedge[b] != -1 {
+					d := m.slack(m.bestedge[b]) / 2
+					if deltatype == -1 || d < delta {
+						delta = d
+						deltatype = 3
+						deltaedge = m.bestedge[b]
+					}
+				}
+			}
+
+			// Delta 4: the minimum z variable of any T-blossom
+			for b := n; b < 2*n; b++ {
+				if m.blossombase[b] >= 0 && m.blossomparent[b] == -1 && m.label[b] == 2 &&
+					(deltatype == -1 || m.dualvar[b] < delta) {
+					delta = m.dualvar[b]
+					deltatype = 4
+					deltablossom = b
+				}
+			}
+
+			if deltatype == -1 {
+				// No further improvement possible; max-cardinality optimum reached.
+				// Do a final delta update to make the optimum verifiable.
+				deltatype = 1
+				delta = minInt64(m.dualvar[:n])
+				if delta < 0 {
+					delta = 0
+				}
+			}
+
+			// Update dual variables according to delta
+			for v := 0; v < n; v++ {
+				switch m.label[m.inblossom[v]] {
+				case 1:
+					m.dualvar[v] -= delta
+				case 2:
+					m.dualvar[v] += delta
+				}
+			}
+			for b := n; b < 2*n; b++ {
+				if m.blossombase[b] >= 0 && m.blossomparent[b] == -1 {
+					switch m.label[b] {
+					case 1:
+						m.dualvar[b] += delta
+					case 2:
+						m.dualvar[b] -= delta
+					}
+				}
+			}
+
+			// Take action at the point where the minimum delta occurred
+			if deltatype == 1 {
+				// No further improvement possible; optimum reached
+				break
+			} else if deltatype == 2 {
+				// Use the least-slack edge to continue the search
+				m.allowedge[deltaedge] = true
+				i := m.edges[deltaedge].U
+				if m.label[m.inblossom[i]] == 0 {
+					i = m.edges[deltaedge].V
+				}
+				m.queue = append(m.queue, i)
+			} else if deltatype == 3 {
+				// Use the least-slack edge to continue the search
+				m.allowedge[deltaedge] = true
+				m.queue = append(m.queue, m.edges[deltaedge].U)
+			} else if deltatype == 4 {
+				// Expand the least-z blossom
+				m.expandBlossom(deltablossom, false)
+			}
+		}
+
+		// Stop when no more augmenting paths can be found
+		if !augmented {
+			break
+		}
+
+		// End of stage; expand all S-blossoms which have dualvar = 0
+		for b := n; b < 2*n; b++ {
+			if m.blossomparent[b] == -1 && m.blossombase[b] >= 0 && m.label[b] == 1 && m.dualvar[b] == 0 {
+				m.expandBlossom(b, true)
+			}
+		}
+	}
+}
+
+// at indexes s, allowing negative indices counted from the end like Python
+func at(s []int, i int) int {
+	if i < 0 {
+		return s[len(s)+i]
+	}
+	return s[i]
+}
+
+func indexOf(s []int, value int) int {
+	for i, v := range s {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
+
+func reverseInts(s []int) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+func minInt64(s []int64) int64 {
+	min := s[0]
+	for _, v := range s[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return min
+}
@@ -0,0 +1,182 @@
+package matching
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// bruteForceMatching returns the cardinality and weight of the best matching
+// of the graph, found by trying every matching
+func bruteForceMatching(n int, edges []Edge, maxCardinality bool) (int, int64) {
+	weight := make(map[[2]int]int64)
+	for _, e := range edges {
+		u, v := min(e.U, e.V), max(e.U, e.V)
+		if w, ok := weight[[2]int{u, v}]; !ok || e.Weight > w {
+			weight[[2]int{u, v}] = e.Weight
+		}
+	}
+
+	bestCount, bestWeight := 0, int64(0)
+	matched := make([]bool, n)
+	var search func(v, count int, total int64)
+	search = func(v, count int, total int64) {
+		for v < n && matched[v] {
+			v++
+		}
+		if v == n {
+			better := total > bestWeight
+			if maxCardinality {
+				better = count > bestCount || count == bestCount && total > bestWeight
+			}
+			if better {
+				bestCount, bestWeight = count, total
+			}
+			return
+		}
+		// Leave v unmatched, or match it with a later vertex
+		matched[v] = true
+		search(v+1, count, total)
+		for u := v + 1; u < n; u++ {
+			if w, ok := weight[[2]int{v, u}]; ok && !matched[u] {
+				matched[u] = true
+				search(v+1, count+1, total+w)
+				matched[u] = false
+			}
+		}
+		matched[v] = false
+	}
+	search(0, 0, 0)
+	return bestCount, bestWeight
+}
+
+// checkMatching verifies that mate is a matching over the edges and returns
+// its cardinality and weight
+func checkMatching(t *testing.T, n int, edges []Edge, mate []int) (int, int64) {
+	t.Helper()
+	weight := make(map[[2]int]int64)
+	for _, e := range edges {
+		u, v := min(e.U, e.V), max(e.U, e.V)
+		if w, ok := weight[[2]int{u, v}]; !ok || e.Weight > w {
+			weight[[2]int{u, v}] = e.Weight
+		}
+	}
+	if len(mate) != n {
+		t.Fatalf("got %d mates, want %d", len(mate), n)
+	}
+
+	var count int
+	var total int64
+	for v, u := range mate {
+		if u == -1 {
+			continue
+		}
+		if mate[u] != v {
+			t.Fatalf("vertex %d is matched to %d, but %d is matched to %d", v, u, u, mate[u])
+		}
+		w, ok := weight[[2]int{min(u, v), max(u, v)}]
+		if !ok {
+			t.Fatalf("vertices %d and %d are matched without an edge", v, u)
+		}
+		if v < u {
+			count++
+			total += w
+		}
+	}
+	return count, total
+}
+
+func TestMaxWeightMatching(t *testing.T) {
+	tests := []struct {
+		name           string
+		n              int
+		edges          []Edge
+		maxCardinality bool
+		wantCount      int
+		wantWeight     int64
+	}{
+		{
+			name: "empty",
+			n:    3,
+		},
+		{
+			name:       "single edge",
+			n:          2,
+			edges:      []Edge{{0, 1, 5}},
+			wantCount:  1,
+			wantWeight: 5,
+		},
+		{
+			name:       "path prefers heavy middle edge",
+			n:          4,
+			edges:      []Edge{{0, 1, 2}, {1, 2, 10}, {2, 3, 2}},
+			wantCount:  1,
+			wantWeight: 10,
+		},
+		{
+			name:           "path with max cardinality takes both ends",
+			n:              4,
+			edges:          []Edge{{0, 1, 2}, {1, 2, 10}, {2, 3, 2}},
+			maxCardinality: true,
+			wantCount:      2,
+			wantWeight:     4,
+		},
+		{
+			name:       "triangle blossom",
+			n:          4,
+			edges:      []Edge{{0, 1, 6}, {1, 2, 6}, {0, 2, 6}, {2, 3, 5}},
+			wantCount:  2,
+			wantWeight: 11,
+		},
+		{
+			// Odd cycles sharing vertices, which form nested blossoms
+			name:       "nested blossom expansion",
+			n:          9,
+			edges:      []Edge{{1, 2, 45}, {1, 5, 45}, {2, 3, 50}, {3, 4, 45}, {4, 5, 50}, {1, 6, 30}, {3, 8, 35}, {4, 7, 26}, {5, 8, 40}},
+			wantCount:  4,
+			wantWeight: 30 + 50 + 26 + 40,
+		},
+		{
+			name:       "negative weights are never worth taking",
+			n:          3,
+			edges:      []Edge{{0, 1, -1}, {1, 2, -3}},
+			wantCount:  0,
+			wantWeight: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mate := MaxWeightMatching(tt.n, tt.edges, tt.maxCardinality)
+			count, weight := checkMatching(t, tt.n, tt.edges, mate)
+			if count != tt.wantCount || weight != tt.wantWeight {
+				t.Errorf("got %d edges weighing %d, want %d weighing %d", count, weight, tt.wantCount, tt.wantWeight)
+			}
+		})
+	}
+}
+
+func TestMaxWeightMatchingBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 500; iter++ {
+		n := 1 + rng.Intn(9)
+		density := rng.Float64()
+		var edges []Edge
+		for v := 0; v < n; v++ {
+			for u := 0; u < v; u++ {
+				if rng.Float64() < density {
+					// Few distinct weights make ties, which are where blossoms form
+					edges = append(edges, Edge{U: u, V: v, Weight: int64(1 + rng.Intn(6))})
+				}
+			}
+		}
+
+		for _, maxCardinality := range []bool{false, true} {
+			mate := MaxWeightMatching(n, edges, maxCardinality)
+			count, weight := checkMatching(t, n, edges, mate)
+			wantCount, wantWeight := bruteForceMatching(n, edges, maxCardinality)
+			if weight != wantWeight || maxCardinality && count != wantCount {
+				t.Fatalf("n=%d edges=%v maxCardinality=%v: got %d edges weighing %d, want %d weighing %d",
+					n, edges, maxCardinality, count, weight, wantCount, wantWeight)
+			}
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package matching
+
+import "sort"
+
+// candidateGraph builds an approximate similarity graph in which each member is
+// only scored against the k members most likely to be similar to them.
+//
+// Likely matches are found through an inverted index from artists to the
+// members who listed them, weighting each shared artist by both members'
+// inverse ranks, so members with no artist in common are never compared.
+// Members with fewer than k such candidates are topped up from a genre index.
+func candidateGraph(c *Cohort, scorer Scorer, k int) *similarityGraph {
+	n := len(c.Members)
+	g := &similarityGraph{
+		cohort: c,
+		scorer: scorer,
+		sparse: make([]map[int]float64, n),
+	}
+	for i := range g.sparse {
+		g.sparse[i] = make(map[int]float64)
+	}
+
+	byArtist := make(map[int][]int)
+	genres := make([]map[string]float64, n)
+	byGenre := make(map[string][]int)
+	for i, m := range c.Members {
+		for artistID := range m.Ranks {
+			byArtist[artistID] = append(byArtist[artistID], i)
+		}
+		genres[i] = c.genreVector(m)
+		for genre := range genres[i] {
+			byGenre[genre] = append(byGenre[genre], i)
+		}
+	}
+
+	overlap := make(map[int]float64)
+	for i, m := range c.Members {
+		clear(overlap)
+		for artistID, rank := range m.Ranks {
+			for _, j := range byArtist[artistID] {
+				if j != i {
+					overlap[j] += 1 / float64(rank*c.Members[j].Ranks[artistID])
+				}
+			}
+		}
+		candidates := topCandidates(overlap, k)
+
+		if len(candidates) < k {
+			chosen := make(map[int]bool, len(candidates))
+			for _, j := range candidates {
+				chosen[j] = true
+			}
+			clear(overlap)
+			for genre, weight := range genres[i] {
+				for _, j := range byGenre[genre] {
+					if j != i && !chosen[j] {
+						overlap[j] += weight * genres[j][genre]
+					}
+				}
+			}
+			candidates = append(candidates, topCandidates(overlap, k-len(candidates))...)
+		}
+
+		for _, j := range candidates {
+			g.add(i, j)
+		}
+	}
+
+	return g
+}
+
+// topCandidates returns the k members with the highest overlap, best first
+func topCandidates(overlap map[int]float64, k int) []int {
+	members := make([]int, 0, len(overlap))
+	for j := range overlap {
+		members = append(members, j)
+	}
+	sort.Slice(members, func(a, b int) bool {
+		oa, ob := overlap[members[a]], overlap[members[b]]
+		if oa != ob {
+			return oa > ob
+		}
+		return members[a] < members[b]
+	})
+	return members[:min(k, len(members))]
+}
@@ -0,0 +1,103 @@
+package matching
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sukhmai/spotify-match/pkg/db"
+)
+
+// Member is a user taking part in a matching run along with their ranked artists
+type Member struct {
+	User db.User
+	// Ranks maps internal artist IDs to the user's rank for them (1 = top artist)
+	Ranks map[int]int
+	// Priority is set for users carried over from an earlier round, who are matched first
+	Priority bool
+	// Discovery is set for users who want a partner who can broaden their listening
+	Discovery bool
+	// Side is the side of a two-sided round the user signed up for
+	Side string
+}
+
+// Cohort holds everything needed to match the users of a round
+type Cohort struct {
+	RoundID int
+	Members []*Member
+	// Artists maps internal artist IDs to artist details
+	Artists map[int]db.Artist
+	// Artistless holds the users of the round without any artists, who can't
+	// be compared with anyone and are left unmatched
+	Artistless []*Member
+}
+
+// LoadCohort loads the users of a round and their ranked artists from the database.
+// Users without any artists are kept apart in Artistless since they cannot be compared.
+func LoadCohort(ctx context.Context, dbClient *db.DBClient, roundID int) (*Cohort, error) {
+	users, err := dbClient.GetRoundUsers(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+
+	userArtists, err := dbClient.GetRoundUserArtists(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+
+	artists, err := dbClient.GetRoundArtists(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+
+	carriedOver, err := dbClient.GetCarriedOverUserIDs(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+
+	discovery, err := dbClient.GetDiscoveryUserIDs(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+
+	sides, err := dbClient.GetUserSides(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+
+	ranks := make(map[int]map[int]int)
+	for _, ua := range userArtists {
+		if ranks[ua.UserID] == nil {
+			ranks[ua.UserID] = make(map[int]int)
+		}
+		ranks[ua.UserID][ua.ArtistID] = ua.Rank
+	}
+
+	cohort := &Cohort{
+		RoundID: roundID,
+		Artists: artists,
+	}
+	for _, user := range users {
+		member := &Member{
+			User:      user,
+			Ranks:     ranks[user.ID],
+			Priority:  carriedOver[user.ID],
+			Discovery: discovery[user.ID],
+			Side:      sides[user.ID],
+		}
+		if len(member.Ranks) == 0 {
+			cohort.Artistless = append(cohort.Artistless, member)
+			continue
+		}
+		cohort.Members = append(cohort.Members, member)
+	}
+
+	return cohort, nil
+}
+
+// ArtistName returns the name of the artist with the given internal ID
+func (c *Cohort) ArtistName(artistID int) string {
+	if artist, ok := c.Artists[artistID]; ok {
+		return artist.Name
+	}
+	return fmt.Sprintf("Unknown (ID: %d)", artistID)
+}
@@ -0,0 +1,111 @@
+package matching
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	// discoveryGenreTarget is the genre similarity discovery pairs aim for:
+	// enough common ground to get along, but far from identical tastes
+	discoveryGenreTarget = 0.5
+	// minDiscoveryScore is the lowest discovery score a discovery pair may have
+	minDiscoveryScore = 0.25
+)
+
+// DiscoveryScore rates how well two members could broaden each other's
+// listening, from 0 to 1. It is highest for members whose genres overlap
+// moderately but who share few artists, so that each can introduce the other
+// to artists in styles they already like.
+func (c *Cohort) DiscoveryScore(a, b *Member) float64 {
+	genres := cosine(c.genreVector(a), c.genreVector(b))
+	closeness := max(1-math.Abs(genres-discoveryGenreTarget)/discoveryGenreTarget, 0)
+
+	shared := len(CommonArtists(a, b))
+	artists := float64(shared) / float64(len(a.Ranks)+len(b.Ranks)-shared)
+
+	return closeness * (1 - artists)
+}
+
+// MatchDiscovery pairs the members who opted in to discovery with each other,
+// maximizing the total discovery score, and then matches everyone else as in
+// MatchPairs. Opted in members without a good discovery partner, or whose only
+// candidates are past partners or score below the minimum score, join the
+// regular matching. Members carried over from an earlier round are paired first.
+//
+// Discovery pairs keep their real similarity, so they usually score lower than
+// regular pairs. Fairness is only measured over the regular pairs.
+func MatchDiscovery(c *Cohort, opts Options) *Result {
+	var opted []int
+	optedCohort := &Cohort{RoundID: c.RoundID, Artists: c.Artists}
+	for i, m := range c.Members {
+		if m.Discovery {
+			opted = append(opted, i)
+			optedCohort.Members = append(optedCohort.Members, m)
+		}
+	}
+
+	// Every opted in pair is given a discovery score, so score their
+	// similarity exactly too
+	graph := exactGraph(optedCohort, opts.Scorer)
+	acceptable := opts.acceptable(graph)
+
+	// Discovery scores are at most 1, so as in maxWeightPairs a bonus of n per
+	// priority member outweighs any difference in the total
+	priorityBonus := int64(len(opted)) * weightScale
+
+	var edges []Edge
+	for v := range opted {
+		for u := 0; u < v; u++ {
+			a, b := optedCohort.Members[u], optedCohort.Members[v]
+			if !acceptable(u, v) || opts.History.Has(a, b) {
+				continue
+			}
+			score := c.DiscoveryScore(a, b)
+			if score < minDiscoveryScore {
+				continue
+			}
+			weight := int64(score * weightScale)
+			if a.Priority {
+				weight += priorityBonus
+			}
+			if b.Priority {
+				weight += priorityBonus
+			}
+			edges = append(edges, Edge{U: u, V: v, Weight: weight})
+		}
+	}
+	mate := MaxWeightMatching(len(opted), edges, false)
+
+	paired := make(map[int]bool)
+	var pairs [][2]int
+	for u, v := range mate {
+		if v != -1 && u < v {
+			pairs = append(pairs, [2]int{u, v})
+			paired[opted[u]], paired[opted[v]] = true, true
+		}
+	}
+
+	rest := &Cohort{RoundID: c.RoundID, Artists: c.Artists}
+	for i, m := range c.Members {
+		if !paired[i] {
+			rest.Members = append(rest.Members, m)
+		}
+	}
+	result := MatchPairs(rest, opts)
+	result.Stats.Edges += graph.edgeCount()
+
+	for _, p := range pairs {
+		a, b := optedCohort.Members[p[0]], optedCohort.Members[p[1]]
+		pair := c.newPair(opts, a, b, graph.at(p[0], p[1]))
+		pair.Discovery = true
+		result.Pairs = append(result.Pairs, pair)
+	}
+
+	// Report the best matches first
+	sort.SliceStable(result.Pairs, func(i, j int) bool {
+		return result.Pairs[i].Similarity > result.Pairs[j].Similarity
+	})
+
+	return result
+}
@@ -0,0 +1,235 @@
+package matching
+
+import (
+	"math"
+	"sort"
+
+	"github.com/sukhmai/spotify-match/pkg/db"
+)
+
+// RecordedScorer names the similarities recorded when past matches were made,
+// which evaluations compare the strategies against
+const RecordedScorer = "recorded"
+
+// calibrationBuckets is the number of match score ranges responses are grouped into
+const calibrationBuckets = 10
+
+// Outcome is one user's feedback on a past match
+type Outcome struct {
+	A, B *Member
+	// Similarity is the similarity recorded when the match was made
+	Similarity float64
+	Connected  bool
+	Rating     int
+}
+
+// ScoredOutcome is the feedback on a past match along with the similarity a
+// strategy gives the pair
+type ScoredOutcome struct {
+	Similarity float64
+	Connected  bool
+	Rating     int
+}
+
+// CalibrationBucket summarizes the responses on matches within a score range
+type CalibrationBucket struct {
+	MinScore   int
+	MaxScore   int
+	Responses  int
+	Connected  int
+	MeanRating float64
+}
+
+// Evaluation measures how well a strategy's similarities predict the feedback
+// on past matches. Metrics that cannot be computed from the responses, such as
+// the AUC when every user connected, are NaN.
+type Evaluation struct {
+	Scorer    string
+	Responses int
+	// RatingCorrelation is the Spearman rank correlation between similarity and rating
+	RatingCorrelation float64
+	// ConnectedAUC is the chance that a response from a user who connected has a
+	// higher similarity than one from a user who did not; 0.5 is no better than chance
+	ConnectedAUC float64
+	// Calibration groups the responses by match score in ranges of ten, the
+	// last of which also holds perfect scores
+	Calibration []CalibrationBucket
+}
+
+// NewOutcomes pairs a round's feedback with the members of its stored matches.
+// Feedback on matches with a member missing from the cohort is skipped, and
+// the number of skipped responses is returned.
+func NewOutcomes(c *Cohort, matches []db.Match, feedback []db.MatchFeedback) ([]Outcome, int) {
+	members := make(map[int]*Member, len(c.Members))
+	for _, m := range c.Members {
+		members[m.User.ID] = m
+	}
+	byID := make(map[int]db.Match, len(matches))
+	for _, m := range matches {
+		byID[m.ID] = m
+	}
+
+	var outcomes []Outcome
+	var skipped int
+	for _, f := range feedback {
+		match, ok := byID[f.MatchID]
+		a, b := members[match.UserAID], members[match.UserBID]
+		if !ok || a == nil || b == nil {
+			skipped++
+			continue
+		}
+		outcomes = append(outcomes, Outcome{
+			A:          a,
+			B:          b,
+			Similarity: match.Similarity,
+			Connected:  f.Connected,
+			Rating:     f.Rating,
+		})
+	}
+	return outcomes, skipped
+}
+
+// ScoreOutcomes re-scores the pairs of past matches with a strategy
+func ScoreOutcomes(scorer Scorer, outcomes []Outcome) []ScoredOutcome {
+	scored := make([]ScoredOutcome, len(outcomes))
+	for i, o := range outcomes {
+		scored[i] = ScoredOutcome{
+			Similarity: scorer.Similarity(o.A, o.B),
+			Connected:  o.Connected,
+			Rating:     o.Rating,
+		}
+	}
+	return scored
+}
+
+// RecordedOutcomes scores past matches with the similarity recorded when they were made
+func RecordedOutcomes(outcomes []Outcome) []ScoredOutcome {
+	scored := make([]ScoredOutcome, len(outcomes))
+	for i, o := range outcomes {
+		scored[i] = ScoredOutcome{
+			Similarity: o.Similarity,
+			Connected:  o.Connected,
+			Rating:     o.Rating,
+		}
+	}
+	return scored
+}
+
+// Evaluate measures how well the similarities of scored outcomes predict their
+// feedback. The curve turns similarities into the match scores used for the
+// calibration buckets; the rank metrics do not depend on it.
+func Evaluate(scorer string, scored []ScoredOutcome, curve ScoreCurve) Evaluation {
+	eval := Evaluation{
+		Scorer:      scorer,
+		Responses:   len(scored),
+		Calibration: make([]CalibrationBucket, calibrationBuckets),
+	}
+
+	similarities := make([]float64, len(scored))
+	ratings := make([]float64, len(scored))
+	connected := make([]bool, len(scored))
+	for i, s := range scored {
+		similarities[i] = s.Similarity
+		ratings[i] = float64(s.Rating)
+		connected[i] = s.Connected
+	}
+	eval.RatingCorrelation = RankCorrelation(similarities, ratings)
+	eval.ConnectedAUC = AUC(similarities, connected)
+
+	for i := range eval.Calibration {
+		eval.Calibration[i].MinScore = i * 100 / calibrationBuckets
+		eval.Calibration[i].MaxScore = (i+1)*100/calibrationBuckets - 1
+	}
+	eval.Calibration[calibrationBuckets-1].MaxScore = 100
+	for _, s := range scored {
+		b := &eval.Calibration[min(curve.Score(s.Similarity)*calibrationBuckets/100, calibrationBuckets-1)]
+		b.Responses++
+		if s.Connected {
+			b.Connected++
+		}
+		b.MeanRating += float64(s.Rating)
+	}
+	for i := range eval.Calibration {
+		if b := &eval.Calibration[i]; b.Responses > 0 {
+			b.MeanRating /= float64(b.Responses)
+		}
+	}
+	return eval
+}
+
+// RankCorrelation returns the Spearman rank correlation of two equally long
+// series, averaging the ranks of ties. It is NaN when either series is constant.
+func RankCorrelation(x, y []float64) float64 {
+	rx, ry := ranks(x), ranks(y)
+	n := float64(len(rx))
+	if n < 2 {
+		return math.NaN()
+	}
+
+	var meanX, meanY float64
+	for i := range rx {
+		meanX += rx[i]
+		meanY += ry[i]
+	}
+	meanX /= n
+	meanY /= n
+
+	var cov, varX, varY float64
+	for i := range rx {
+		dx, dy := rx[i]-meanX, ry[i]-meanY
+		cov += dx * dy
+		varX += dx * dx
+		varY += dy * dy
+	}
+	if varX == 0 || varY == 0 {
+		return math.NaN()
+	}
+	return cov / math.Sqrt(varX*varY)
+}
+
+// AUC returns the area under the ROC curve of scores predicting the positive
+// labels, counting ties as half. It is NaN unless both labels occur.
+func AUC(scores []float64, positive []bool) float64 {
+	r := ranks(scores)
+	var positives, negatives int
+	var rankSum float64
+	for i, p := range positive {
+		if p {
+			positives++
+			rankSum += r[i]
+		} else {
+			negatives++
+		}
+	}
+	if positives == 0 || negatives == 0 {
+		return math.NaN()
+	}
+	p, n := float64(positives), float64(negatives)
+	return (rankSum - p*(p+1)/2) / (p * n)
+}
+
+// ranks returns the 1-based rank of each value, giving tied values the
+// average of the ranks they span
+func ranks(values []float64) []float64 {
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return values[order[i]] < values[order[j]]
+	})
+
+	r := make([]float64, len(values))
+	for i := 0; i < len(order); {
+		j := i
+		for j+1 < len(order) && values[order[j+1]] == values[order[i]] {
+			j++
+		}
+		rank := float64(i+j)/2 + 1
+		for k := i; k <= j; k++ {
+			r[order[k]] = rank
+		}
+		i = j + 1
+	}
+	return r
+}
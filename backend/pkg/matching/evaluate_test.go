@@ -0,0 +1,67 @@
+package matching
+
+import (
+	"math"
+	"testing"
+)
+
+// sameFloat reports whether two metrics are equal, treating NaN as equal to NaN
+func sameFloat(got, want float64) bool {
+	if math.IsNaN(want) {
+		return math.IsNaN(got)
+	}
+	return math.Abs(got-want) < 1e-9
+}
+
+func TestRankCorrelation(t *testing.T) {
+	tests := []struct {
+		name string
+		x, y []float64
+		want float64
+	}{
+		{name: "same order", x: []float64{1, 2, 3, 4}, y: []float64{10, 20, 30, 40}, want: 1},
+		{name: "reversed", x: []float64{1, 2, 3, 4}, y: []float64{4, 3, 2, 1}, want: -1},
+		{name: "only ranks matter", x: []float64{1, 2, 3}, y: []float64{1, 100, 1000}, want: 1},
+		// Ranks (1, 2, 3, 4) against (1.5, 1.5, 3, 4)
+		{name: "ties share their rank", x: []float64{1, 2, 3, 4}, y: []float64{5, 5, 6, 7}, want: 4.5 / math.Sqrt(5*4.5)},
+		// Ranks (1, 2, 3) against (2, 3, 1)
+		{name: "partial agreement", x: []float64{1, 2, 3}, y: []float64{2, 3, 1}, want: -0.5},
+		{name: "constant series", x: []float64{1, 2, 3}, y: []float64{5, 5, 5}, want: math.NaN()},
+		{name: "single value", x: []float64{1}, y: []float64{2}, want: math.NaN()},
+		{name: "empty", want: math.NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RankCorrelation(tt.x, tt.y); !sameFloat(got, tt.want) {
+				t.Errorf("RankCorrelation(%v, %v) = %v, want %v", tt.x, tt.y, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAUC(t *testing.T) {
+	tests := []struct {
+		name     string
+		scores   []float64
+		positive []bool
+		want     float64
+	}{
+		{name: "positives score highest", scores: []float64{0.1, 0.2, 0.8, 0.9}, positive: []bool{false, false, true, true}, want: 1},
+		{name: "positives score lowest", scores: []float64{0.1, 0.2, 0.8, 0.9}, positive: []bool{true, true, false, false}, want: 0},
+		{name: "all scores tied", scores: []float64{0.5, 0.5, 0.5}, positive: []bool{true, false, true}, want: 0.5},
+		// Of the four positive-negative pairs, (0.4, 0.3) and (0.9, 0.3) are
+		// ordered right, (0.4, 0.6) wrong and (0.9, 0.6) right
+		{name: "mixed", scores: []float64{0.3, 0.4, 0.6, 0.9}, positive: []bool{false, true, false, true}, want: 0.75},
+		// The tied pair counts as half
+		{name: "tie across labels", scores: []float64{0.2, 0.5, 0.5}, positive: []bool{false, true, false}, want: 0.75},
+		{name: "no negatives", scores: []float64{0.1, 0.2}, positive: []bool{true, true}, want: math.NaN()},
+		{name: "no positives", scores: []float64{0.1, 0.2}, positive: []bool{false, false}, want: math.NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AUC(tt.scores, tt.positive); !sameFloat(got, tt.want) {
+				t.Errorf("AUC(%v, %v) = %v, want %v", tt.scores, tt.positive, got, tt.want)
+			}
+		})
+	}
+}
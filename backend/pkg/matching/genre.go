@@ -0,0 +1,108 @@
+package matching
+
+import (
+	"sort"
+)
+
+// DefaultGenreWeight is the share of the similarity given to genre overlap for
+// rounds that do not choose one
+const DefaultGenreWeight = 0.25
+
+// genreVector spreads each of the member's artist weights (1/rank) over the
+// artist's genres, so genres of top artists count most
+func (c *Cohort) genreVector(m *Member) map[string]float64 {
+	vector := make(map[string]float64)
+	for artistID, rank := range m.Ranks {
+		genres := c.Artists[artistID].Genres
+		for _, genre := range genres {
+			vector[genre] += 1 / float64(rank) / float64(len(genres))
+		}
+	}
+	return vector
+}
+
+// CommonGenres returns the genres all the given members listen to, strongest shared genres first
+func (c *Cohort) CommonGenres(members ...*Member) []string {
+	if len(members) == 0 {
+		return nil
+	}
+	vectors := make([]map[string]float64, len(members))
+	for i, m := range members {
+		vectors[i] = c.genreVector(m)
+	}
+
+	strength := make(map[string]float64)
+	for genre, weight := range vectors[0] {
+		for _, v := range vectors[1:] {
+			weight *= v[genre]
+		}
+		if weight > 0 {
+			strength[genre] = weight
+		}
+	}
+
+	common := make([]string, 0, len(strength))
+	for genre := range strength {
+		common = append(common, genre)
+	}
+	sort.Slice(common, func(i, j int) bool {
+		wi, wj := strength[common[i]], strength[common[j]]
+		if wi != wj {
+			return wi > wj
+		}
+		return common[i] < common[j]
+	})
+	return common
+}
+
+// genreBlendScorer mixes an artist-level scorer with the cosine similarity of the
+// members' genre vectors, so users with adjacent but non-identical tastes still
+// get a meaningful score
+type genreBlendScorer struct {
+	artists Scorer
+	cohort  *Cohort
+	weight  float64
+	vectors map[*Member]map[string]float64
+}
+
+// NewGenreBlend wraps scorer so that weight (0-1) of the similarity comes from
+// genre overlap and the rest from the wrapped artist-level scorer
+func NewGenreBlend(scorer Scorer, cohort *Cohort, weight float64) Scorer {
+	return &genreBlendScorer{
+		artists: scorer,
+		cohort:  cohort,
+		weight:  weight,
+		vectors: make(map[*Member]map[string]float64),
+	}
+}
+
+func (s *genreBlendScorer) Name() string {
+	return s.artists.Name() + "+genres"
+}
+
+func (s *genreBlendScorer) Similarity(a, b *Member) float64 {
+	return (1-s.weight)*s.artists.Similarity(a, b) + s.weight*s.GenreSimilarity(a, b)
+}
+
+func (s *genreBlendScorer) Explain(a, b *Member) Breakdown {
+	breakdown := s.artists.Explain(a, b)
+	for artistID, contribution := range breakdown.Artists {
+		breakdown.Artists[artistID] = (1 - s.weight) * contribution
+	}
+	breakdown.Genres = (1-s.weight)*breakdown.Genres + s.weight*s.GenreSimilarity(a, b)
+	return breakdown
+}
+
+// GenreSimilarity returns the cosine similarity of the members' genre vectors
+func (s *genreBlendScorer) GenreSimilarity(a, b *Member) float64 {
+	return cosine(s.vector(a), s.vector(b))
+}
+
+func (s *genreBlendScorer) vector(m *Member) map[string]float64 {
+	if v, ok := s.vectors[m]; ok {
+		return v
+	}
+	v := s.cohort.genreVector(m)
+	s.vectors[m] = v
+	return v
+}
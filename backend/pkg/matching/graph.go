@@ -0,0 +1,135 @@
+package matching
+
+import "sort"
+
+// similarityGraph holds the pairwise similarities considered when matching.
+// In exact mode every pair of members is scored up front; in approximate mode
+// only candidate pairs are, and every other pair counts as dissimilar.
+type similarityGraph struct {
+	cohort *Cohort
+	scorer Scorer
+	dense  [][]float64
+	// sparse maps each member to the similarity of their candidates
+	sparse []map[int]float64
+}
+
+// exactGraph scores every pair of members of the cohort
+func exactGraph(c *Cohort, scorer Scorer) *similarityGraph {
+	n := len(c.Members)
+	sim := make([][]float64, n)
+	for i := range sim {
+		sim[i] = make([]float64, n)
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			sim[i][j] = scorer.Similarity(c.Members[i], c.Members[j])
+			sim[j][i] = sim[i][j]
+		}
+	}
+	return &similarityGraph{cohort: c, scorer: scorer, dense: sim}
+}
+
+// approximate reports whether only candidate pairs were scored
+func (g *similarityGraph) approximate() bool {
+	return g.dense == nil
+}
+
+// at returns the similarity of two members, or 0 if they are not candidates
+func (g *similarityGraph) at(i, j int) float64 {
+	if g.dense != nil {
+		return g.dense[i][j]
+	}
+	return g.sparse[i][j]
+}
+
+// exact returns the similarity of two members, scoring them if they are not candidates
+func (g *similarityGraph) exact(i, j int) float64 {
+	if g.dense != nil {
+		return g.dense[i][j]
+	}
+	if s, ok := g.sparse[i][j]; ok {
+		return s
+	}
+	return g.scorer.Similarity(g.cohort.Members[i], g.cohort.Members[j])
+}
+
+// total returns the sum of a member's similarities to everyone else
+func (g *similarityGraph) total(i int) float64 {
+	var sum float64
+	if g.dense != nil {
+		for _, s := range g.dense[i] {
+			sum += s
+		}
+		return sum
+	}
+	for _, s := range g.sparse[i] {
+		sum += s
+	}
+	return sum
+}
+
+// edgeCount returns the number of scored pairs
+func (g *similarityGraph) edgeCount() int {
+	if g.dense != nil {
+		n := len(g.dense)
+		return n * (n - 1) / 2
+	}
+	var count int
+	for _, neighbours := range g.sparse {
+		count += len(neighbours)
+	}
+	return count / 2
+}
+
+// forEachPair calls fn with the positions in members of every scored pair among them
+func (g *similarityGraph) forEachPair(members []int, fn func(u, v int)) {
+	if g.dense != nil {
+		for v := range members {
+			for u := 0; u < v; u++ {
+				fn(u, v)
+			}
+		}
+		return
+	}
+
+	pos := make(map[int]int, len(members))
+	for u, i := range members {
+		pos[i] = u
+	}
+	for u, i := range members {
+		// Visit neighbours in a fixed order so that runs are reproducible
+		neighbours := make([]int, 0, len(g.sparse[i]))
+		for j := range g.sparse[i] {
+			if v, ok := pos[j]; ok && u < v {
+				neighbours = append(neighbours, v)
+			}
+		}
+		sort.Ints(neighbours)
+		for _, v := range neighbours {
+			fn(u, v)
+		}
+	}
+}
+
+// complete scores every pair among the given members, so that members left
+// without a candidate can still be matched with each other
+func (g *similarityGraph) complete(members []int) {
+	if g.dense != nil {
+		return
+	}
+	for v, j := range members {
+		for _, i := range members[:v] {
+			g.add(i, j)
+		}
+	}
+}
+
+// add scores a pair of members if they have not been scored yet
+func (g *similarityGraph) add(i, j int) {
+	if _, ok := g.sparse[i][j]; ok {
+		return
+	}
+	s := g.scorer.Similarity(g.cohort.Members[i], g.cohort.Members[j])
+	g.sparse[i][j] = s
+	g.sparse[j][i] = s
+}
@@ -0,0 +1,292 @@
+package matching
+
+import (
+	"sort"
+
+	"github.com/sukhmai/spotify-match/pkg/db"
+)
+
+// Matching modes a round can use
+const (
+	// ModePairs matches users in pairs with the blossom algorithm
+	ModePairs = "pairs"
+	// ModeGroups partitions users into small groups
+	ModeGroups = "groups"
+	// ModeStable matches users in pairs that no two users would both rather leave
+	ModeStable = "stable"
+	// ModeDiscovery pairs users who opted in with someone of complementary taste
+	ModeDiscovery = "discovery"
+	// ModeBipartite matches users in pairs across the two sides of the round
+	ModeBipartite = "bipartite"
+)
+
+// Group sizes allowed in group mode
+const (
+	DefaultGroupSize = 3
+	MinGroupSize     = 3
+	MaxGroupSize     = 6
+)
+
+// maxSwapPasses bounds the local search in MatchGroups; each pass tries every
+// swap between two groups and it usually settles after a handful of passes
+const maxSwapPasses = 50
+
+// Group is a set of members matched with each other
+type Group struct {
+	Members []*Member
+	// Similarity is the mean similarity over all pairs in the group
+	Similarity float64
+	MatchScore int
+	// CommonArtists holds the internal IDs of the artists every member shares
+	CommonArtists []int
+	// CommonGenres holds the genres every member listens to
+	CommonGenres []string
+}
+
+// ModeNames returns the names of all matching modes
+func ModeNames() []string {
+	return []string{ModePairs, ModeGroups, ModeStable, ModeDiscovery, ModeBipartite}
+}
+
+// IsMode reports whether mode is a known matching mode
+func IsMode(mode string) bool {
+	for _, m := range ModeNames() {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchGroups partitions the cohort into groups of at least size members,
+// maximizing the total similarity within groups. When the cohort does not
+// divide evenly, some groups get one extra member, up to MaxGroupSize. Members
+// who still don't fit, or everyone when there are fewer than size, are left
+// unmatched, and members carried over from an earlier round are placed ahead of
+// everyone else. Groups scoring below the minimum score are dissolved and their
+// members left unmatched.
+//
+// Groups are seeded greedily and then improved by swapping members between
+// groups while that increases the total, so the result is a good partition
+// rather than a guaranteed optimum.
+func MatchGroups(c *Cohort, opts Options, size int) *Result {
+	n := len(c.Members)
+	result := &Result{RoundID: c.RoundID}
+	if n < 2 {
+		result.Unmatched = append(result.Unmatched, c.Members...)
+		return result
+	}
+
+	graph := opts.graph(c)
+
+	// Optimize against similarities that push previously matched members apart,
+	// while reporting the real similarity of each group
+	objective := graph.at
+	if len(opts.History) > 0 {
+		objective = func(i, j int) float64 {
+			if opts.History.Has(c.Members[i], c.Members[j]) {
+				return graph.at(i, j) - repeatPenalty
+			}
+			return graph.at(i, j)
+		}
+	}
+
+	groups, leftover := seedGroups(graph, objective, groupSizes(n, size))
+	improveGroups(objective, groups)
+
+	result.Stats.Edges = graph.edgeCount()
+	for _, idx := range leftover {
+		result.Unmatched = append(result.Unmatched, c.Members[idx])
+	}
+	for _, indexes := range groups {
+		members := make([]*Member, len(indexes))
+		for i, idx := range indexes {
+			members[i] = c.Members[idx]
+		}
+		mean := groupSimilarity(graph, indexes)
+		if MatchScore(mean) < opts.MinScore {
+			result.Unmatched = append(result.Unmatched, members...)
+			continue
+		}
+		result.Groups = append(result.Groups, Group{
+			Members:       members,
+			Similarity:    mean,
+			MatchScore:    MatchScore(mean),
+			CommonArtists: CommonArtists(members...),
+			CommonGenres:  c.CommonGenres(members...),
+		})
+		result.RelaxedUsers = append(result.RelaxedUsers, opts.History.repeatedMembers(members)...)
+	}
+
+	// Report the best groups first
+	sort.SliceStable(result.Groups, func(i, j int) bool {
+		return result.Groups[i].Similarity > result.Groups[j].Similarity
+	})
+
+	return result
+}
+
+// groupSizes splits n members into as many groups of at least size as possible,
+// spreading the remainder one extra member at a time up to MaxGroupSize. It
+// returns no groups when there are fewer than size members.
+func groupSizes(n, size int) []int {
+	count := n / size
+	sizes := make([]int, count)
+	for i := range sizes {
+		sizes[i] = n / count
+		if i < n%count {
+			sizes[i]++
+		}
+		sizes[i] = min(sizes[i], MaxGroupSize)
+	}
+	return sizes
+}
+
+// seedGroups builds an initial partition. Each group is started from the member
+// least similar to the existing seeds, so that groups form around different
+// tastes, and then the remaining members join the open group they fit best,
+// priority members first. It also returns the members left over once every
+// group is full.
+func seedGroups(graph *similarityGraph, sim func(i, j int) float64, sizes []int) ([][]int, []int) {
+	n := len(graph.cohort.Members)
+	groups := make([][]int, len(sizes))
+	assigned := make([]bool, n)
+
+	// A member's mean similarity to a group is between -repeatPenalty and 1, so
+	// this bonus places priority members ahead of everyone else
+	priorityBonus := 1 + repeatPenalty
+
+	for g := range groups {
+		seed, seedScore := -1, 0.0
+		for i := 0; i < n; i++ {
+			if assigned[i] {
+				continue
+			}
+			// Closeness to the existing seeds, or for the first seed the
+			// negated total similarity so that the best-connected member starts
+			var score float64
+			for _, other := range groups[:g] {
+				score = max(score, sim(i, other[0]))
+			}
+			if g == 0 {
+				score = -graph.total(i)
+			}
+			if seed == -1 || score < seedScore {
+				seed, seedScore = i, score
+			}
+		}
+		groups[g] = []int{seed}
+		assigned[seed] = true
+	}
+
+	for {
+		// Place the unassigned member with the strongest fit first
+		best, bestGroup, bestGain := -1, -1, 0.0
+		for i := 0; i < n; i++ {
+			if assigned[i] {
+				continue
+			}
+			for g, group := range groups {
+				if len(group) >= sizes[g] {
+					continue
+				}
+				gain := 0.0
+				for _, j := range group {
+					gain += sim(i, j)
+				}
+				gain /= float64(len(group))
+				if graph.cohort.Members[i].Priority {
+					gain += priorityBonus
+				}
+				if best == -1 || gain > bestGain {
+					best, bestGroup, bestGain = i, g, gain
+				}
+			}
+		}
+		if best == -1 {
+			var leftover []int
+			for i := 0; i < n; i++ {
+				if !assigned[i] {
+					leftover = append(leftover, i)
+				}
+			}
+			return groups, leftover
+		}
+		groups[bestGroup] = append(groups[bestGroup], best)
+		assigned[best] = true
+	}
+}
+
+// improveGroups swaps members between groups while any swap increases the
+// total similarity within groups
+func improveGroups(sim func(i, j int) float64, groups [][]int) {
+	// affinity returns the similarity of member i to the members of group other than skip
+	affinity := func(i int, group []int, skip int) float64 {
+		var sum float64
+		for _, j := range group {
+			if j != skip {
+				sum += sim(i, j)
+			}
+		}
+		return sum
+	}
+
+	for pass := 0; pass < maxSwapPasses; pass++ {
+		improved := false
+		for g1 := range groups {
+			for g2 := g1 + 1; g2 < len(groups); g2++ {
+				for x, a := range groups[g1] {
+					for y, b := range groups[g2] {
+						delta := affinity(b, groups[g1], a) - affinity(a, groups[g1], a) +
+							affinity(a, groups[g2], b) - affinity(b, groups[g2], b)
+						if delta > 1e-12 {
+							groups[g1][x], groups[g2][y] = b, a
+							a = b
+							improved = true
+						}
+					}
+				}
+			}
+		}
+		if !improved {
+			return
+		}
+	}
+}
+
+// groupSimilarity returns the mean pairwise similarity of a group, scoring
+// pairs that were not candidates in approximate mode
+func groupSimilarity(graph *similarityGraph, group []int) float64 {
+	var sum float64
+	var pairs int
+	for i := 0; i < len(group); i++ {
+		for j := 0; j < i; j++ {
+			sum += graph.exact(group[i], group[j])
+			pairs++
+		}
+	}
+	if pairs == 0 {
+		return 0
+	}
+	return sum / float64(pairs)
+}
+
+// MatchGroups converts the groups to records that can be stored in the database
+func (r *Result) MatchGroups() []db.MatchGroup {
+	groups := make([]db.MatchGroup, len(r.Groups))
+	for i, group := range r.Groups {
+		userIDs := make([]int, len(group.Members))
+		for j, m := range group.Members {
+			userIDs[j] = m.User.ID
+		}
+		groups[i] = db.MatchGroup{
+			RoundID:         r.RoundID,
+			UserIDs:         userIDs,
+			Similarity:      group.Similarity,
+			MatchScore:      group.MatchScore,
+			SharedArtistIDs: group.CommonArtists,
+			SharedGenres:    group.CommonGenres,
+		}
+	}
+	return groups
+}
@@ -0,0 +1,187 @@
+package matching
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestGroupSizes(t *testing.T) {
+	tests := []struct {
+		n, size int
+		want    []int
+	}{
+		{n: 0, size: 3, want: []int{}},
+		{n: 2, size: 3, want: []int{}},
+		{n: 3, size: 3, want: []int{3}},
+		{n: 5, size: 3, want: []int{5}},
+		{n: 7, size: 3, want: []int{4, 3}},
+		{n: 9, size: 3, want: []int{3, 3, 3}},
+		{n: 11, size: 3, want: []int{4, 4, 3}},
+		{n: 10, size: 4, want: []int{5, 5}},
+		// Groups never grow past MaxGroupSize, so the rest are left over
+		{n: 11, size: 6, want: []int{6}},
+		{n: 13, size: 5, want: []int{6, 6}},
+	}
+	for _, tt := range tests {
+		if got := groupSizes(tt.n, tt.size); !slices.Equal(got, tt.want) {
+			t.Errorf("groupSizes(%d, %d) = %v, want %v", tt.n, tt.size, got, tt.want)
+		}
+	}
+}
+
+// groupTotal returns the total similarity within the groups
+func groupTotal(sim func(i, j int) float64, groups [][]int) float64 {
+	var total float64
+	for _, group := range groups {
+		for x := range group {
+			for y := 0; y < x; y++ {
+				total += sim(group[x], group[y])
+			}
+		}
+	}
+	return total
+}
+
+func TestImproveGroups(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 200; iter++ {
+		n := 6 + rng.Intn(7)
+		weights := make([][]float64, n)
+		for i := range weights {
+			weights[i] = make([]float64, n)
+		}
+		for i := 0; i < n; i++ {
+			for j := 0; j < i; j++ {
+				weights[i][j] = rng.Float64()
+				weights[j][i] = weights[i][j]
+			}
+		}
+		sim := func(i, j int) float64 { return weights[i][j] }
+
+		perm := rng.Perm(n)
+		var groups [][]int
+		for _, size := range groupSizes(n, 3) {
+			groups = append(groups, slices.Clone(perm[:size]))
+			perm = perm[size:]
+		}
+		before := groupTotal(sim, groups)
+		var sizes []int
+		for _, group := range groups {
+			sizes = append(sizes, len(group))
+		}
+
+		improveGroups(sim, groups)
+
+		var members []int
+		for g, group := range groups {
+			if len(group) != sizes[g] {
+				t.Fatalf("group %d has %d members, want %d", g, len(group), sizes[g])
+			}
+			members = append(members, group...)
+		}
+		slices.Sort(members)
+		for i, m := range members {
+			if m != i {
+				t.Fatalf("groups %v do not hold every member exactly once", groups)
+			}
+		}
+
+		after := groupTotal(sim, groups)
+		if after < before-1e-9 {
+			t.Fatalf("total similarity fell from %v to %v", before, after)
+		}
+		// No swap between two groups can raise the total any further
+		for g1 := range groups {
+			for g2 := g1 + 1; g2 < len(groups); g2++ {
+				for x := range groups[g1] {
+					for y := range groups[g2] {
+						groups[g1][x], groups[g2][y] = groups[g2][y], groups[g1][x]
+						swapped := groupTotal(sim, groups)
+						groups[g1][x], groups[g2][y] = groups[g2][y], groups[g1][x]
+						if swapped > after+1e-9 {
+							t.Fatalf("swapping members %d and %d of groups %v raises the total from %v to %v",
+								x, y, groups, after, swapped)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// artistCohort builds a cohort whose members each list the given artists
+func artistCohort(artists [][]int, priority map[int]bool) *Cohort {
+	c := &Cohort{}
+	for i, list := range artists {
+		ranks := make(map[int]int, len(list))
+		for k, artistID := range list {
+			ranks[artistID] = k + 1
+		}
+		m := &Member{Ranks: ranks, Priority: priority[i]}
+		m.User.ID = i
+		c.Members = append(c.Members, m)
+	}
+	return c
+}
+
+func TestMatchGroupsLeftovers(t *testing.T) {
+	tests := []struct {
+		name          string
+		artists       [][]int
+		size          int
+		priority      map[int]bool
+		wantGroups    []int
+		wantUnmatched int
+	}{
+		{
+			name:          "fewer members than the group size",
+			artists:       [][]int{{1}, {1}},
+			size:          3,
+			wantUnmatched: 2,
+		},
+		{
+			name:       "remainder joins the groups",
+			artists:    [][]int{{1}, {1}, {1}, {2}, {2}, {2}, {2}},
+			size:       3,
+			wantGroups: []int{4, 3},
+		},
+		{
+			// Only six of the seven members fit; the priority member shares
+			// nothing with anyone but is still placed ahead of the rest
+			name:          "priority members are placed first",
+			artists:       [][]int{{1}, {1}, {1}, {1}, {1}, {1}, {9}},
+			size:          6,
+			priority:      map[int]bool{6: true},
+			wantGroups:    []int{6},
+			wantUnmatched: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := artistCohort(tt.artists, tt.priority)
+			scorer, err := NewScorer(DefaultScorer, c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			result := MatchGroups(c, Options{Scorer: scorer}, tt.size)
+
+			var sizes []int
+			for _, g := range result.Groups {
+				sizes = append(sizes, len(g.Members))
+			}
+			slices.SortFunc(sizes, func(a, b int) int { return b - a })
+			if !slices.Equal(sizes, tt.wantGroups) {
+				t.Errorf("got group sizes %v, want %v", sizes, tt.wantGroups)
+			}
+			if len(result.Unmatched) != tt.wantUnmatched {
+				t.Errorf("got %d unmatched, want %d", len(result.Unmatched), tt.wantUnmatched)
+			}
+			for _, m := range result.Unmatched {
+				if m.Priority {
+					t.Errorf("priority member %d was left unmatched", m.User.ID)
+				}
+			}
+		})
+	}
+}
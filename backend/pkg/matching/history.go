@@ -0,0 +1,47 @@
+package matching
+
+import "github.com/sukhmai/spotify-match/pkg/db"
+
+// repeatPenalty is subtracted from the similarity of previously matched users
+// when forming groups, which is enough to keep them apart whenever possible
+// since similarities are at most 1
+const repeatPenalty = 2.0
+
+// History records which users have been matched with each other before
+type History map[[2]int]bool
+
+// NewHistory builds a history from previously matched pairs of users
+func NewHistory(pairs []db.UserPair) History {
+	h := make(History, len(pairs))
+	for _, p := range pairs {
+		h[historyKey(p.UserAID, p.UserBID)] = true
+	}
+	return h
+}
+
+// Has reports whether the two users have been matched before
+func (h History) Has(a, b *Member) bool {
+	return h[historyKey(a.User.ID, b.User.ID)]
+}
+
+func historyKey(a, b int) [2]int {
+	if a > b {
+		a, b = b, a
+	}
+	return [2]int{a, b}
+}
+
+// repeatedMembers returns the members of a group who were matched before with
+// someone else in the group
+func (h History) repeatedMembers(members []*Member) []*Member {
+	var repeated []*Member
+	for i := range members {
+		for j := range members {
+			if i != j && h.Has(members[i], members[j]) {
+				repeated = append(repeated, members[i])
+				break
+			}
+		}
+	}
+	return repeated
+}
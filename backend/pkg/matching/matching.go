@@ -0,0 +1,359 @@
+package matching
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/sukhmai/spotify-match/pkg/db"
+)
+
+// weightScale converts float similarities to the integer edge weights used by
+// MaxWeightMatching while keeping six decimal places of precision
+const weightScale = 1_000_000
+
+// Pair is two members matched with each other
+type Pair struct {
+	A, B       *Member
+	Similarity float64
+	MatchScore int
+	// CommonArtists holds the internal IDs of the artists both members share
+	CommonArtists []int
+	// Contributions holds how much each common artist adds to the similarity,
+	// in the same order as CommonArtists
+	Contributions []float64
+	// GenreContribution is how much genre overlap adds to the similarity
+	GenreContribution float64
+	// CommonGenres holds the genres both members listen to
+	CommonGenres []string
+	// Repeat is set when the members were matched in an earlier round
+	Repeat bool
+	// Discovery is set when the members were paired to broaden each other's listening
+	Discovery bool
+}
+
+// Result is the outcome of a matching run
+type Result struct {
+	RoundID int
+	Pairs   []Pair
+	// Groups is set instead of Pairs in group mode
+	Groups    []Group
+	Unmatched []*Member
+	// RelaxedUsers are the members who could only be matched with someone
+	// they were matched with in an earlier round
+	RelaxedUsers []*Member
+	// Fairness measures how members rank their partners, in pair modes
+	Fairness Fairness
+	// Unstable is set when stable mode found no stable matching and fell
+	// back to maximizing total similarity
+	Unstable bool
+	// Sides counts the members of each side and how many were matched, in
+	// bipartite mode
+	Sides []SideCount
+	// Unsided are the members who did not choose one of the round's sides,
+	// and so could not be matched in bipartite mode
+	Unsided []*Member
+	Stats   Stats
+}
+
+// Stats describes the cost of a matching run
+type Stats struct {
+	// Edges is the number of pairs of members that were scored
+	Edges int
+	// Elapsed is the time spent scoring and matching
+	Elapsed time.Duration
+	// AllocatedBytes is the memory allocated while scoring and matching
+	AllocatedBytes uint64
+}
+
+// Options configures a matching run
+type Options struct {
+	// Scorer measures the similarity of two members
+	Scorer Scorer
+	// Mode selects pairs, groups, stable pairs, discovery pairs or pairs across sides
+	Mode string
+	// Objective selects what pairs mode optimizes
+	Objective string
+	// GroupSize is the minimum size of each group in group mode
+	GroupSize int
+	// History holds the pairs matched in earlier rounds, which are avoided
+	// unless there is no other way to match a member
+	History History
+	// MinScore is the lowest match score (0-100) a pair or group may have.
+	// Members who cannot reach it are left unmatched.
+	MinScore int
+	// Candidates limits how many likely matches each member is scored against.
+	// Zero scores every pair, which is exact but quadratic in the cohort size.
+	Candidates int
+	// Sides names the two sides of the round, which bipartite mode matches across
+	Sides []string
+}
+
+// OptionsForRound builds the matching options selected in a round's settings
+func OptionsForRound(c *Cohort, settings db.RoundSettings) (Options, error) {
+	scorerName := settings.Scorer
+	if scorerName == "" {
+		scorerName = DefaultScorer
+	}
+	scorer, err := NewScorer(scorerName, c)
+	if err != nil {
+		return Options{}, err
+	}
+	if settings.GenreWeight < 0 || settings.GenreWeight > 1 {
+		return Options{}, fmt.Errorf("genre weight must be between 0 and 1, got %v", settings.GenreWeight)
+	}
+	if settings.GenreWeight > 0 {
+		scorer = NewGenreBlend(scorer, c, settings.GenreWeight)
+	}
+
+	if settings.MinScore < 0 || settings.MinScore > 100 {
+		return Options{}, fmt.Errorf("minimum score must be between 0 and 100, got %d", settings.MinScore)
+	}
+
+	if settings.Candidates < 0 {
+		return Options{}, fmt.Errorf("candidates must not be negative, got %d", settings.Candidates)
+	}
+
+	opts := Options{
+		Scorer:     scorer,
+		Mode:       settings.Mode,
+		Objective:  settings.Objective,
+		GroupSize:  settings.GroupSize,
+		MinScore:   settings.MinScore,
+		Candidates: settings.Candidates,
+		Sides:      settings.Sides,
+	}
+	if opts.Mode == "" {
+		opts.Mode = ModePairs
+	}
+	if !IsMode(opts.Mode) {
+		return Options{}, fmt.Errorf("unknown matching mode %q", opts.Mode)
+	}
+	if opts.Objective == "" {
+		opts.Objective = ObjectiveTotal
+	}
+	if !IsObjective(opts.Objective) {
+		return Options{}, fmt.Errorf("unknown matching objective %q", opts.Objective)
+	}
+	if opts.Mode == ModeBipartite && len(opts.Sides) != 2 {
+		return Options{}, fmt.Errorf("bipartite mode needs two sides, got %d", len(opts.Sides))
+	}
+	if opts.GroupSize == 0 {
+		opts.GroupSize = DefaultGroupSize
+	}
+	if opts.GroupSize < MinGroupSize || opts.GroupSize > MaxGroupSize {
+		return Options{}, fmt.Errorf("group size must be between %d and %d, got %d", MinGroupSize, MaxGroupSize, opts.GroupSize)
+	}
+	return opts, nil
+}
+
+// Match runs the matching mode selected in the options, recording its cost.
+// Users without artists are added to the unmatched members, so that they are
+// carried over like everyone else who was left out.
+func Match(c *Cohort, opts Options) *Result {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+
+	var result *Result
+	switch opts.Mode {
+	case ModeGroups:
+		result = MatchGroups(c, opts, opts.GroupSize)
+	case ModeStable:
+		result = MatchStable(c, opts)
+	case ModeDiscovery:
+		result = MatchDiscovery(c, opts)
+	case ModeBipartite:
+		result = MatchBipartite(c, opts)
+	default:
+		result = MatchPairs(c, opts)
+	}
+	result.Unmatched = append(result.Unmatched, c.Artistless...)
+
+	result.Stats.Elapsed = time.Since(start)
+	runtime.ReadMemStats(&after)
+	result.Stats.AllocatedBytes = after.TotalAlloc - before.TotalAlloc
+	return result
+}
+
+// graph scores the pairs of members considered for matching
+func (opts Options) graph(c *Cohort) *similarityGraph {
+	if opts.Candidates > 0 {
+		return candidateGraph(c, opts.Scorer, opts.Candidates)
+	}
+	return exactGraph(c, opts.Scorer)
+}
+
+// MatchPairs pairs up the members of the cohort so that the total similarity of
+// all pairs is maximized, matching as many members as possible. Pairs scoring
+// below the minimum score are never formed, and members carried over from an
+// earlier round are matched ahead of everyone else.
+//
+// Members matched in an earlier round are first kept apart. Whoever is left
+// over is then matched among themselves with that constraint relaxed.
+//
+// In approximate mode members left without a candidate partner are scored
+// against each other and matched before the constraint is relaxed.
+//
+// The bottleneck objective first finds the highest minimum score at which as
+// many members can be matched, and then maximizes the total above it.
+func MatchPairs(c *Cohort, opts Options) *Result {
+	graph := opts.graph(c)
+	if opts.Objective == ObjectiveBottleneck {
+		opts.MinScore = bottleneckScore(c, opts, graph)
+	}
+	return matchPairs(c, opts, graph)
+}
+
+// matchPairs runs MatchPairs, forming only pairs that reach the minimum score
+func matchPairs(c *Cohort, opts Options, graph *similarityGraph) *Result {
+	acceptable := opts.acceptable(graph)
+	strict := func(i, j int) bool {
+		return acceptable(i, j) && !opts.History.Has(c.Members[i], c.Members[j])
+	}
+
+	all := make([]int, len(c.Members))
+	for i := range all {
+		all[i] = i
+	}
+	pairs, unmatched := maxWeightPairs(c, graph, all, strict)
+
+	if graph.approximate() && len(unmatched) > 1 {
+		graph.complete(unmatched)
+		var more [][2]int
+		more, unmatched = maxWeightPairs(c, graph, unmatched, strict)
+		pairs = append(pairs, more...)
+	}
+
+	var relaxed [][2]int
+	if len(opts.History) > 0 && len(unmatched) > 1 {
+		relaxed, unmatched = maxWeightPairs(c, graph, unmatched, acceptable)
+	}
+
+	return pairResult(c, opts, graph, append(pairs, relaxed...), unmatched)
+}
+
+// acceptable reports whether two members may be matched and score high enough
+func (opts Options) acceptable(graph *similarityGraph) func(i, j int) bool {
+	return func(i, j int) bool {
+		return MatchScore(graph.at(i, j)) >= opts.MinScore &&
+			opts.pairable(graph.cohort.Members[i], graph.cohort.Members[j])
+	}
+}
+
+// pairResult builds the result of matching the given pairs, flagging pairs
+// that were matched in an earlier round and measuring how fair the matching is
+func pairResult(c *Cohort, opts Options, graph *similarityGraph, pairs [][2]int, unmatched []int) *Result {
+	result := &Result{RoundID: c.RoundID, Stats: Stats{Edges: graph.edgeCount()}}
+	for _, p := range pairs {
+		a, b := c.Members[p[0]], c.Members[p[1]]
+		pair := c.newPair(opts, a, b, graph.at(p[0], p[1]))
+		result.Pairs = append(result.Pairs, pair)
+		// Only passes that relax the history constraint can pair past partners
+		if pair.Repeat {
+			result.RelaxedUsers = append(result.RelaxedUsers, a, b)
+		}
+	}
+	for _, i := range unmatched {
+		result.Unmatched = append(result.Unmatched, c.Members[i])
+	}
+	result.Fairness = pairFairness(c, opts, graph, pairs)
+
+	// Report the best matches first
+	sort.SliceStable(result.Pairs, func(i, j int) bool {
+		return result.Pairs[i].Similarity > result.Pairs[j].Similarity
+	})
+
+	return result
+}
+
+// newPair describes two matched members with the given similarity
+func (c *Cohort) newPair(opts Options, a, b *Member, similarity float64) Pair {
+	common := CommonArtists(a, b)
+	breakdown := opts.Scorer.Explain(a, b)
+	contributions := make([]float64, len(common))
+	for k, artistID := range common {
+		contributions[k] = breakdown.Artists[artistID]
+	}
+	return Pair{
+		A:                 a,
+		B:                 b,
+		Similarity:        similarity,
+		MatchScore:        MatchScore(similarity),
+		CommonArtists:     common,
+		Contributions:     contributions,
+		GenreContribution: breakdown.Genres,
+		CommonGenres:      c.CommonGenres(a, b),
+		Repeat:            opts.History.Has(a, b),
+	}
+}
+
+// maxWeightPairs matches the given members using only the allowed pairs,
+// returning the matched pairs and the members left over
+func maxWeightPairs(c *Cohort, graph *similarityGraph, members []int, allowed func(i, j int) bool) ([][2]int, []int) {
+	n := len(members)
+
+	// A matching's total similarity is at most n/2, so a bonus of n per
+	// priority member outweighs any difference in similarity
+	priorityBonus := int64(n) * weightScale
+
+	// Build a graph of the allowed pairs weighted by similarity
+	var edges []Edge
+	graph.forEachPair(members, func(u, v int) {
+		i, j := members[u], members[v]
+		if !allowed(i, j) {
+			return
+		}
+		weight := int64(graph.at(i, j) * weightScale)
+		if c.Members[i].Priority {
+			weight += priorityBonus
+		}
+		if c.Members[j].Priority {
+			weight += priorityBonus
+		}
+		edges = append(edges, Edge{U: u, V: v, Weight: weight})
+	})
+
+	mate := MaxWeightMatching(n, edges, true)
+
+	var pairs [][2]int
+	var unmatched []int
+	for u, v := range mate {
+		switch {
+		case v == -1:
+			unmatched = append(unmatched, members[u])
+		case u < v:
+			pairs = append(pairs, [2]int{members[u], members[v]})
+		}
+	}
+	return pairs, unmatched
+}
+
+// UnmatchedUserIDs returns the IDs of the members left unmatched
+func (r *Result) UnmatchedUserIDs() []int {
+	ids := make([]int, len(r.Unmatched))
+	for i, m := range r.Unmatched {
+		ids[i] = m.User.ID
+	}
+	return ids
+}
+
+// Matches converts the matched pairs to records that can be stored in the database
+func (r *Result) Matches() []db.Match {
+	matches := make([]db.Match, len(r.Pairs))
+	for i, pair := range r.Pairs {
+		matches[i] = db.Match{
+			RoundID:             r.RoundID,
+			UserAID:             pair.A.User.ID,
+			UserBID:             pair.B.User.ID,
+			Similarity:          pair.Similarity,
+			MatchScore:          pair.MatchScore,
+			SharedArtistIDs:     pair.CommonArtists,
+			ArtistContributions: pair.Contributions,
+			GenreContribution:   pair.GenreContribution,
+			SharedGenres:        pair.CommonGenres,
+		}
+	}
+	return matches
+}
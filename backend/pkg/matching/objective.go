@@ -0,0 +1,103 @@
+package matching
+
+import "sort"
+
+// Objectives pairs mode can optimize
+const (
+	// ObjectiveTotal maximizes the total similarity of all pairs
+	ObjectiveTotal = "total"
+	// ObjectiveBottleneck maximizes the lowest match score of any pair, and
+	// then the total similarity
+	ObjectiveBottleneck = "bottleneck"
+)
+
+// ObjectiveNames returns the names of all pair matching objectives
+func ObjectiveNames() []string {
+	return []string{ObjectiveTotal, ObjectiveBottleneck}
+}
+
+// IsObjective reports whether name is a known objective
+func IsObjective(name string) bool {
+	for _, o := range ObjectiveNames() {
+		if o == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ObjectiveScores summarizes the match scores of the pairs found under one objective
+type ObjectiveScores struct {
+	Objective   string
+	Matches     int
+	MinScore    int
+	MedianScore float64
+	MeanScore   float64
+}
+
+// bottleneckScore returns the highest minimum score at which as many members
+// can still be matched as at the configured minimum score. Like the first pass
+// of MatchPairs, it only counts pairs that were not matched in earlier rounds.
+func bottleneckScore(c *Cohort, opts Options, graph *similarityGraph) int {
+	all := make([]int, len(c.Members))
+	for i := range all {
+		all[i] = i
+	}
+
+	// matched returns the largest number of members that can be matched in
+	// pairs scoring at least score
+	matched := func(score int) int {
+		scoreOpts := opts
+		scoreOpts.MinScore = score
+		acceptable := scoreOpts.acceptable(graph)
+		var edges []Edge
+		graph.forEachPair(all, func(i, j int) {
+			if acceptable(i, j) && !opts.History.Has(c.Members[i], c.Members[j]) {
+				edges = append(edges, Edge{U: i, V: j, Weight: 1})
+			}
+		})
+		var count int
+		for _, v := range MaxWeightMatching(len(all), edges, true) {
+			if v != -1 {
+				count++
+			}
+		}
+		return count
+	}
+
+	// Raising the minimum only removes pairs, so the count can only fall
+	target := matched(opts.MinScore)
+	return opts.MinScore + sort.Search(100-opts.MinScore, func(k int) bool {
+		return matched(opts.MinScore+k+1) < target
+	})
+}
+
+// CompareObjectives matches the cohort in pairs under every objective so that
+// their score distributions can be compared on the same input
+func CompareObjectives(c *Cohort, opts Options) []ObjectiveScores {
+	var scores []ObjectiveScores
+	for _, objective := range ObjectiveNames() {
+		opts.Objective = objective
+		result := MatchPairs(c, opts)
+
+		summary := ObjectiveScores{Objective: objective, Matches: len(result.Pairs)}
+		if len(result.Pairs) > 0 {
+			matchScores := make([]int, len(result.Pairs))
+			var total int
+			for i, p := range result.Pairs {
+				matchScores[i] = p.MatchScore
+				total += p.MatchScore
+			}
+			sort.Ints(matchScores)
+			mid := len(matchScores) / 2
+			summary.MinScore = matchScores[0]
+			summary.MedianScore = float64(matchScores[mid])
+			if len(matchScores)%2 == 0 {
+				summary.MedianScore = float64(matchScores[mid-1]+matchScores[mid]) / 2
+			}
+			summary.MeanScore = float64(total) / float64(len(matchScores))
+		}
+		scores = append(scores, summary)
+	}
+	return scores
+}
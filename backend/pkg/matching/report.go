@@ -0,0 +1,108 @@
+package matching
+
+// DefaultReportSample is the number of best and worst matches shown in a report
+const DefaultReportSample = 5
+
+// Report summarizes the outcome of a matching run so that settings can be
+// tuned before results are saved
+type Report struct {
+	Users   int
+	Matches int
+	// MeanScore is the average match score over all pairs or groups
+	MeanScore float64
+	// ScoreHistogram counts matches by score in buckets of ten: 0-9, 10-19, ..., 90-100
+	ScoreHistogram [10]int
+	Unmatched      int
+	Relaxed        int
+	// Discovery counts the pairs formed to broaden each other's listening
+	Discovery int
+	// Sides counts each side's members and matches in bipartite mode, and
+	// Unsided the members who chose neither side
+	Sides   []SideCount
+	Unsided int
+	// NoSharedArtists counts matches whose members have no artist in common
+	NoSharedArtists int
+	// Fairness is set for pair modes
+	Fairness Fairness
+	Unstable bool
+	// Objectives compares the pairs found under each objective on the same
+	// cohort. It is left for callers to fill in with CompareObjectives, since that
+	// matches the cohort again for every objective
+	Objectives []ObjectiveScores
+	// Top holds the best matches, best first, and Bottom the worst, worst first
+	Top    []ReportEntry
+	Bottom []ReportEntry
+	Stats  Stats
+}
+
+// ReportEntry is a single pair or group in a report
+type ReportEntry struct {
+	Members       []*Member
+	Similarity    float64
+	MatchScore    int
+	CommonArtists []int
+}
+
+// NewReport summarizes a matching result, keeping sample of the best and worst matches
+func NewReport(c *Cohort, r *Result, sample int) *Report {
+	entries := r.entries()
+	report := &Report{
+		Users:     len(c.Members) + len(c.Artistless),
+		Matches:   len(entries),
+		Unmatched: len(r.Unmatched),
+		Relaxed:   len(r.RelaxedUsers),
+		Fairness:  r.Fairness,
+		Unstable:  r.Unstable,
+		Sides:     r.Sides,
+		Unsided:   len(r.Unsided),
+		Stats:     r.Stats,
+	}
+
+	for _, p := range r.Pairs {
+		if p.Discovery {
+			report.Discovery++
+		}
+	}
+
+	var total int
+	for _, e := range entries {
+		total += e.MatchScore
+		report.ScoreHistogram[min(e.MatchScore/10, 9)]++
+		if len(e.CommonArtists) == 0 {
+			report.NoSharedArtists++
+		}
+	}
+	if len(entries) > 0 {
+		report.MeanScore = float64(total) / float64(len(entries))
+	}
+
+	// Entries are ordered best first
+	report.Top = entries[:min(sample, len(entries))]
+	for i := len(entries) - 1; i >= max(len(entries)-sample, 0); i-- {
+		report.Bottom = append(report.Bottom, entries[i])
+	}
+
+	return report
+}
+
+// entries lists the pairs or groups of the result, best first
+func (r *Result) entries() []ReportEntry {
+	var entries []ReportEntry
+	for _, p := range r.Pairs {
+		entries = append(entries, ReportEntry{
+			Members:       []*Member{p.A, p.B},
+			Similarity:    p.Similarity,
+			MatchScore:    p.MatchScore,
+			CommonArtists: p.CommonArtists,
+		})
+	}
+	for _, g := range r.Groups {
+		entries = append(entries, ReportEntry{
+			Members:       g.Members,
+			Similarity:    g.Similarity,
+			MatchScore:    g.MatchScore,
+			CommonArtists: g.CommonArtists,
+		})
+	}
+	return entries
+}
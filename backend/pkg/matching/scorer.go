@@ -0,0 +1,211 @@
+package matching
+
+import (
+	"fmt"
+	"math"
+)
+
+// Names of the available similarity strategies
+const (
+	// ScorerRank weights artists by their raw rank, as matching.py did.
+	// A user's #50 artist weighs fifty times more than their #1, so it is
+	// only kept for comparison with past rounds.
+	ScorerRank = "rank"
+	// ScorerInverseRank weights artists by 1/rank so top artists count most
+	ScorerInverseRank = "inverse_rank"
+	// ScorerBinary counts every listed artist equally
+	ScorerBinary = "binary"
+	// ScorerJaccard is the size of the overlap divided by the size of the union
+	ScorerJaccard = "jaccard"
+	// ScorerPopularityIDF discounts mainstream artists using Spotify popularity
+	ScorerPopularityIDF = "popularity_idf"
+	// ScorerCohortIDF discounts artists that many users in the round listed
+	ScorerCohortIDF = "cohort_idf"
+)
+
+// DefaultScorer is used for rounds that do not select a strategy
+const DefaultScorer = ScorerInverseRank
+
+// Scorer measures how similar two members' tastes are, from 0 (nothing in common) to 1
+type Scorer interface {
+	// Name identifies the strategy when selecting it for a round
+	Name() string
+	// Similarity returns how similar the two members are
+	Similarity(a, b *Member) float64
+	// Explain splits the similarity of two members into the parts contributed
+	// by each shared artist and by genre overlap
+	Explain(a, b *Member) Breakdown
+}
+
+// Breakdown is a similarity split into its contributions, which add up to the similarity
+type Breakdown struct {
+	// Artists maps the internal IDs of shared artists to their contribution
+	Artists map[int]float64
+	// Genres is the contribution of genre overlap
+	Genres float64
+}
+
+// ScorerNames returns the names of all available strategies
+func ScorerNames() []string {
+	return []string{
+		ScorerRank,
+		ScorerInverseRank,
+		ScorerBinary,
+		ScorerJaccard,
+		ScorerPopularityIDF,
+		ScorerCohortIDF,
+	}
+}
+
+// IsScorer reports whether name is a known strategy
+func IsScorer(name string) bool {
+	for _, n := range ScorerNames() {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// NewScorer creates the named strategy. The cohort provides the artist details
+// and listening frequencies used by the IDF strategies.
+func NewScorer(name string, cohort *Cohort) (Scorer, error) {
+	switch name {
+	case ScorerRank:
+		return newVectorScorer(name, func(artistID, rank int) float64 {
+			return float64(rank)
+		}), nil
+	case ScorerInverseRank:
+		return newVectorScorer(name, func(artistID, rank int) float64 {
+			return 1 / float64(rank)
+		}), nil
+	case ScorerBinary:
+		return newVectorScorer(name, func(artistID, rank int) float64 {
+			return 1
+		}), nil
+	case ScorerJaccard:
+		return jaccardScorer{}, nil
+	case ScorerPopularityIDF:
+		idf := popularityIDF(cohort)
+		return newVectorScorer(name, func(artistID, rank int) float64 {
+			return idf[artistID] / float64(rank)
+		}), nil
+	case ScorerCohortIDF:
+		idf := cohortIDF(cohort)
+		return newVectorScorer(name, func(artistID, rank int) float64 {
+			return idf[artistID] / float64(rank)
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown scorer %q, must be one of %v", name, ScorerNames())
+	}
+}
+
+// vectorScorer compares members by the cosine similarity of weighted artist vectors
+type vectorScorer struct {
+	name   string
+	weight func(artistID, rank int) float64
+	// vectors caches each member's weighted vector
+	vectors map[*Member]map[int]float64
+}
+
+func newVectorScorer(name string, weight func(artistID, rank int) float64) *vectorScorer {
+	return &vectorScorer{
+		name:    name,
+		weight:  weight,
+		vectors: make(map[*Member]map[int]float64),
+	}
+}
+
+func (s *vectorScorer) Name() string {
+	return s.name
+}
+
+func (s *vectorScorer) Similarity(a, b *Member) float64 {
+	return cosine(s.vector(a), s.vector(b))
+}
+
+// Explain splits the cosine similarity into the products of each shared
+// artist's weights, scaled by the vector norms
+func (s *vectorScorer) Explain(a, b *Member) Breakdown {
+	va, vb := s.vector(a), s.vector(b)
+	breakdown := Breakdown{Artists: make(map[int]float64)}
+	scale := norm(va) * norm(vb)
+	if scale == 0 {
+		return breakdown
+	}
+	for artistID, wa := range va {
+		if wb, ok := vb[artistID]; ok {
+			breakdown.Artists[artistID] = wa * wb / scale
+		}
+	}
+	return breakdown
+}
+
+func (s *vectorScorer) vector(m *Member) map[int]float64 {
+	if v, ok := s.vectors[m]; ok {
+		return v
+	}
+	v := make(map[int]float64, len(m.Ranks))
+	for artistID, rank := range m.Ranks {
+		v[artistID] = s.weight(artistID, rank)
+	}
+	s.vectors[m] = v
+	return v
+}
+
+// jaccardScorer compares the sets of artists, ignoring ranks
+type jaccardScorer struct{}
+
+func (jaccardScorer) Name() string {
+	return ScorerJaccard
+}
+
+func (jaccardScorer) Similarity(a, b *Member) float64 {
+	shared := len(CommonArtists(a, b))
+	union := len(a.Ranks) + len(b.Ranks) - shared
+	if union == 0 {
+		return 0
+	}
+	return float64(shared) / float64(union)
+}
+
+// Explain credits every shared artist equally
+func (s jaccardScorer) Explain(a, b *Member) Breakdown {
+	breakdown := Breakdown{Artists: make(map[int]float64)}
+	common := CommonArtists(a, b)
+	if len(common) == 0 {
+		return breakdown
+	}
+	share := s.Similarity(a, b) / float64(len(common))
+	for _, artistID := range common {
+		breakdown.Artists[artistID] = share
+	}
+	return breakdown
+}
+
+// popularityIDF weights artists by the inverse of their Spotify popularity (0-100),
+// treating popularity as the share of listeners who know the artist
+func popularityIDF(cohort *Cohort) map[int]float64 {
+	idf := make(map[int]float64, len(cohort.Artists))
+	for artistID, artist := range cohort.Artists {
+		idf[artistID] = math.Log(1 + 100/float64(artist.Popularity+1))
+	}
+	return idf
+}
+
+// cohortIDF weights artists by the inverse of how many members listed them
+func cohortIDF(cohort *Cohort) map[int]float64 {
+	counts := make(map[int]int)
+	for _, m := range cohort.Members {
+		for artistID := range m.Ranks {
+			counts[artistID]++
+		}
+	}
+
+	n := float64(len(cohort.Members))
+	idf := make(map[int]float64, len(counts))
+	for artistID, count := range counts {
+		idf[artistID] = math.Log(1 + n/float64(count))
+	}
+	return idf
+}
@@ -0,0 +1,93 @@
+package matching
+
+import (
+	"math"
+	"sort"
+)
+
+// cosine returns the cosine similarity of two sparse vectors
+func cosine[K comparable](a, b map[K]float64) float64 {
+	if len(b) < len(a) {
+		a, b = b, a
+	}
+	var dot float64
+	for k, va := range a {
+		if vb, ok := b[k]; ok {
+			dot += va * vb
+		}
+	}
+	if dot == 0 {
+		return 0
+	}
+	return dot / (norm(a) * norm(b))
+}
+
+func norm[K comparable](v map[K]float64) float64 {
+	var sum float64
+	for _, x := range v {
+		sum += x * x
+	}
+	return math.Sqrt(sum)
+}
+
+// CommonArtists returns the artists shared by all the given members, ordered by
+// their combined rank so that the artists everyone ranks highly come first
+func CommonArtists(members ...*Member) []int {
+	if len(members) == 0 {
+		return nil
+	}
+
+	var common []int
+	for artistID := range members[0].Ranks {
+		if listedByAll(members[1:], artistID) {
+			common = append(common, artistID)
+		}
+	}
+	combinedRank := func(artistID int) int {
+		var sum int
+		for _, m := range members {
+			sum += m.Ranks[artistID]
+		}
+		return sum
+	}
+	sort.Slice(common, func(i, j int) bool {
+		ri, rj := combinedRank(common[i]), combinedRank(common[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return common[i] < common[j]
+	})
+	return common
+}
+
+func listedByAll(members []*Member, artistID int) bool {
+	for _, m := range members {
+		if _, ok := m.Ranks[artistID]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ScoreCurve is a sigmoid that transforms similarities into match scores
+type ScoreCurve struct {
+	// Steepness controls how steep the curve is
+	Steepness float64
+	// Midpoint is the similarity that maps to 50
+	Midpoint float64
+}
+
+// DefaultScoreCurve maps low similarities to good scores
+var DefaultScoreCurve = ScoreCurve{Steepness: 15, Midpoint: 0.15}
+
+// Score transforms a similarity into a match score (0-100)
+func (curve ScoreCurve) Score(similarity float64) int {
+	score := 100 / (1 + math.Exp(-curve.Steepness*(similarity-curve.Midpoint)))
+	return int(math.Round(score))
+}
+
+// MatchScore transforms a similarity into a user-friendly match score (0-100)
+// using the default curve
+func MatchScore(similarity float64) int {
+	return DefaultScoreCurve.Score(similarity)
+}
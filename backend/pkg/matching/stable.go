@@ -0,0 +1,306 @@
+package matching
+
+import "sort"
+
+// Fairness describes how members rank the partners they were matched with
+type Fairness struct {
+	// MeanPartnerRank is the average position of each matched member's partner
+	// in their own preference order, where 1 is their most similar member
+	MeanPartnerRank float64
+	// WorstPartnerRank is the lowest position any member's partner has
+	WorstPartnerRank int
+	// BlockingPairs counts pairs of members who would both rather be matched
+	// with each other than with their partners
+	BlockingPairs int
+}
+
+// MatchStable pairs up the members of the cohort so that no two members would
+// both rather be matched with each other than with their partners, using
+// Irving's stable roommates algorithm. Each member prefers the members most
+// similar to them, with past partners ranked below everyone else and pairs
+// below the minimum score left out. Carried over members get no priority.
+//
+// A stable matching does not always exist. When it does not, the members are
+// matched as in MatchPairs instead and the result is marked unstable, so that
+// the round is still matched and the report shows how many pairs would rather
+// swap.
+func MatchStable(c *Cohort, opts Options) *Result {
+	graph := opts.graph(c)
+	acceptable := opts.acceptable(graph)
+
+	pairs, unmatched, ok := stableRoommates(preferences(c, opts, graph))
+	if !ok {
+		result := matchPairs(c, opts, graph)
+		result.Unstable = true
+		return result
+	}
+
+	// In approximate mode members may be left out only because they were not
+	// scored against each other
+	if graph.approximate() && len(unmatched) > 1 {
+		graph.complete(unmatched)
+		var more [][2]int
+		more, unmatched = maxWeightPairs(c, graph, unmatched, acceptable)
+		pairs = append(pairs, more...)
+	}
+
+	return pairResult(c, opts, graph, pairs, unmatched)
+}
+
+// preferences lists, for each member, the members they may be matched with
+// from most to least preferred
+func preferences(c *Cohort, opts Options, graph *similarityGraph) [][]int {
+	acceptable := opts.acceptable(graph)
+	n := len(c.Members)
+	weight := func(i, j int) float64 {
+		if opts.History.Has(c.Members[i], c.Members[j]) {
+			return graph.at(i, j) - repeatPenalty
+		}
+		return graph.at(i, j)
+	}
+
+	all := make([]int, n)
+	for i := range all {
+		all[i] = i
+	}
+	prefs := make([][]int, n)
+	graph.forEachPair(all, func(i, j int) {
+		if acceptable(i, j) {
+			prefs[i] = append(prefs[i], j)
+			prefs[j] = append(prefs[j], i)
+		}
+	})
+	for i := range prefs {
+		sort.SliceStable(prefs[i], func(a, b int) bool {
+			wa, wb := weight(i, prefs[i][a]), weight(i, prefs[i][b])
+			if wa != wb {
+				return wa > wb
+			}
+			return prefs[i][a] < prefs[i][b]
+		})
+	}
+	return prefs
+}
+
+// preferenceRanks maps each member to the position of every other member in
+// their preference list
+func preferenceRanks(prefs [][]int) []map[int]int {
+	ranks := make([]map[int]int, len(prefs))
+	for i, list := range prefs {
+		ranks[i] = make(map[int]int, len(list))
+		for k, j := range list {
+			ranks[i][j] = k
+		}
+	}
+	return ranks
+}
+
+// roommates holds the preference table reduced by Irving's algorithm. Lists
+// are only ever shortened by cutting them off after some member, so a member
+// j is still on i's list when each appears before the cut on the other's list.
+type roommates struct {
+	prefs [][]int
+	ranks []map[int]int
+	// head is the position in prefs of the first member that may still be on
+	// the list, and last the position of the cut
+	head []int
+	last []int
+}
+
+// has reports whether the member at position k of i's preferences is still on i's list
+func (r *roommates) has(i, k int) bool {
+	if k < r.head[i] || k > r.last[i] {
+		return false
+	}
+	j := r.prefs[i][k]
+	return r.ranks[j][i] <= r.last[j]
+}
+
+// first returns the member at the top of i's list, or -1 if it is empty
+func (r *roommates) first(i int) int {
+	for r.head[i] <= r.last[i] && !r.has(i, r.head[i]) {
+		r.head[i]++
+	}
+	if r.head[i] > r.last[i] {
+		return -1
+	}
+	return r.prefs[i][r.head[i]]
+}
+
+// second returns the member after the top of i's list, or -1 if there is none
+func (r *roommates) second(i int) int {
+	if r.first(i) == -1 {
+		return -1
+	}
+	for k := r.head[i] + 1; k <= r.last[i]; k++ {
+		if r.has(i, k) {
+			return r.prefs[i][k]
+		}
+	}
+	return -1
+}
+
+// lastOf returns the member at the bottom of i's list, or -1 if it is empty
+func (r *roommates) lastOf(i int) int {
+	for r.last[i] >= r.head[i] && !r.has(i, r.last[i]) {
+		r.last[i]--
+	}
+	if r.last[i] < r.head[i] {
+		return -1
+	}
+	return r.prefs[i][r.last[i]]
+}
+
+// cut removes everyone after j from i's list
+func (r *roommates) cut(i, j int) {
+	r.last[i] = r.ranks[i][j]
+}
+
+// stableRoommates finds a stable matching for the given preference lists,
+// returning the matched pairs and the members left over. It reports false
+// when no stable matching exists.
+func stableRoommates(prefs [][]int) ([][2]int, []int, bool) {
+	n := len(prefs)
+	r := &roommates{
+		prefs: prefs,
+		ranks: preferenceRanks(prefs),
+		head:  make([]int, n),
+		last:  make([]int, n),
+	}
+	for i := range prefs {
+		r.last[i] = len(prefs[i]) - 1
+	}
+
+	// Phase 1: everyone proposes down their list and each member holds on to
+	// the best proposal so far, cutting their list after that proposer
+	holder := make([]int, n)
+	queue := make([]int, n)
+	for i := range holder {
+		holder[i] = -1
+		queue[i] = i
+	}
+	for len(queue) > 0 {
+		x := queue[0]
+		queue = queue[1:]
+		y := r.first(x)
+		if y == -1 {
+			continue
+		}
+		// x is still on y's list, so y prefers x to whoever y was holding
+		if holder[y] != -1 {
+			queue = append(queue, holder[y])
+		}
+		holder[y] = x
+		r.cut(y, x)
+	}
+
+	// Members whose lists emptied cannot be matched in any stable matching
+	var matched []int
+	for i := range prefs {
+		if r.first(i) != -1 {
+			matched = append(matched, i)
+		}
+	}
+
+	// Phase 2: eliminate rotations until every list has a single member
+	for {
+		start := -1
+		for _, i := range matched {
+			if r.second(i) != -1 {
+				start = i
+				break
+			}
+		}
+		if start == -1 {
+			break
+		}
+
+		// Follow second choices until a member repeats, which closes a rotation
+		var seq []int
+		seen := make(map[int]int)
+		p := start
+		for {
+			if k, ok := seen[p]; ok {
+				seq = seq[k:]
+				break
+			}
+			seen[p] = len(seq)
+			seq = append(seq, p)
+			q := r.second(p)
+			if q == -1 {
+				return nil, nil, false
+			}
+			p = r.lastOf(q)
+		}
+
+		// Each member's second choice now cuts their list after them, which
+		// moves every member of the rotation on to their second choice
+		seconds := make([]int, len(seq))
+		for k, p := range seq {
+			seconds[k] = r.second(p)
+		}
+		for k, p := range seq {
+			r.cut(seconds[k], p)
+		}
+
+		for _, i := range matched {
+			if r.first(i) == -1 {
+				return nil, nil, false
+			}
+		}
+	}
+
+	var pairs [][2]int
+	var unmatched []int
+	for i := range prefs {
+		switch j := r.first(i); {
+		case j == -1:
+			unmatched = append(unmatched, i)
+		case i < j:
+			pairs = append(pairs, [2]int{i, j})
+		}
+	}
+	return pairs, unmatched, true
+}
+
+// pairFairness measures how every matched member ranks their partner and
+// counts the pairs who would rather be matched with each other
+func pairFairness(c *Cohort, opts Options, graph *similarityGraph, pairs [][2]int) Fairness {
+	var fairness Fairness
+	if len(pairs) == 0 {
+		return fairness
+	}
+
+	prefs := preferences(c, opts, graph)
+	ranks := preferenceRanks(prefs)
+	partner := make([]int, len(c.Members))
+	for i := range partner {
+		partner[i] = -1
+	}
+	for _, p := range pairs {
+		partner[p[0]], partner[p[1]] = p[1], p[0]
+	}
+
+	var total int
+	for _, p := range pairs {
+		for _, i := range p {
+			rank := ranks[i][partner[i]] + 1
+			total += rank
+			fairness.WorstPartnerRank = max(fairness.WorstPartnerRank, rank)
+		}
+	}
+	fairness.MeanPartnerRank = float64(total) / float64(2*len(pairs))
+
+	// prefers reports whether i would rather be with j than their partner
+	prefers := func(i, j int) bool {
+		return partner[i] == -1 || ranks[i][j] < ranks[i][partner[i]]
+	}
+	for i, list := range prefs {
+		for _, j := range list {
+			if i < j && partner[i] != j && prefers(i, j) && prefers(j, i) {
+				fairness.BlockingPairs++
+			}
+		}
+	}
+	return fairness
+}
@@ -0,0 +1,197 @@
+package matching
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// blockingPair returns a pair who would both rather be with each other than
+// with their partners in the matching, or false if the matching is stable
+func blockingPair(prefs [][]int, partner []int) ([2]int, bool) {
+	ranks := preferenceRanks(prefs)
+	prefers := func(i, j int) bool {
+		return partner[i] == -1 || ranks[i][j] < ranks[i][partner[i]]
+	}
+	for i, list := range prefs {
+		for _, j := range list {
+			if partner[i] != j && prefers(i, j) && prefers(j, i) {
+				return [2]int{i, j}, true
+			}
+		}
+	}
+	return [2]int{}, false
+}
+
+// bruteForceStable returns the partners of every stable matching of the
+// preferences, found by trying every matching
+func bruteForceStable(prefs [][]int) [][]int {
+	n := len(prefs)
+	partner := make([]int, n)
+	for i := range partner {
+		partner[i] = -1
+	}
+	decided := make([]bool, n)
+
+	var stable [][]int
+	var search func(i int)
+	search = func(i int) {
+		for i < n && decided[i] {
+			i++
+		}
+		if i == n {
+			if _, ok := blockingPair(prefs, partner); !ok {
+				stable = append(stable, slices.Clone(partner))
+			}
+			return
+		}
+		// Leave i unmatched, or match them with a later member on their list
+		decided[i] = true
+		search(i + 1)
+		for _, j := range prefs[i] {
+			if j > i && !decided[j] {
+				decided[j] = true
+				partner[i], partner[j] = j, i
+				search(i + 1)
+				partner[i], partner[j] = -1, -1
+				decided[j] = false
+			}
+		}
+		decided[i] = false
+	}
+	search(0)
+	return stable
+}
+
+// partners converts pairs and unmatched members to each member's partner,
+// failing if a member appears twice or a pair is not on both lists
+func partners(t *testing.T, prefs [][]int, pairs [][2]int, unmatched []int) []int {
+	t.Helper()
+	partner := make([]int, len(prefs))
+	seen := make([]bool, len(prefs))
+	mark := func(i int) {
+		if seen[i] {
+			t.Fatalf("member %d appears more than once", i)
+		}
+		seen[i] = true
+	}
+	for _, p := range pairs {
+		mark(p[0])
+		mark(p[1])
+		if !slices.Contains(prefs[p[0]], p[1]) || !slices.Contains(prefs[p[1]], p[0]) {
+			t.Fatalf("members %d and %d are matched without finding each other acceptable", p[0], p[1])
+		}
+		partner[p[0]], partner[p[1]] = p[1], p[0]
+	}
+	for _, i := range unmatched {
+		mark(i)
+		partner[i] = -1
+	}
+	for i, ok := range seen {
+		if !ok {
+			t.Fatalf("member %d is neither matched nor unmatched", i)
+		}
+	}
+	return partner
+}
+
+func TestStableRoommates(t *testing.T) {
+	tests := []struct {
+		name  string
+		prefs [][]int
+		// want is each member's partner, or nil when no stable matching exists
+		want []int
+	}{
+		{
+			name:  "mutual first choices",
+			prefs: [][]int{{1, 2, 3}, {0, 2, 3}, {3, 0, 1}, {2, 0, 1}},
+			want:  []int{1, 0, 3, 2},
+		},
+		{
+			// Everyone ranks 3 last, and 0, 1 and 2 each prefer the next one
+			// round the cycle, so whoever is paired with 3 breaks it up
+			name:  "no stable matching",
+			prefs: [][]int{{1, 2, 3}, {2, 0, 3}, {0, 1, 3}, {0, 1, 2}},
+		},
+		{
+			// Six members whose only stable matching gives most of them a later choice
+			name: "six members",
+			prefs: [][]int{
+				{3, 5, 1, 4, 2},
+				{5, 2, 4, 0, 3},
+				{3, 4, 0, 5, 1},
+				{1, 5, 4, 0, 2},
+				{3, 1, 0, 2, 5},
+				{4, 0, 2, 3, 1},
+			},
+			want: []int{5, 2, 1, 4, 3, 0},
+		},
+		{
+			name:  "members without acceptable partners are left over",
+			prefs: [][]int{{1}, {0}, {}},
+			want:  []int{1, 0, -1},
+		},
+		{
+			name:  "odd cohort leaves one member out",
+			prefs: [][]int{{1, 2}, {0, 2}, {0, 1}},
+			want:  []int{1, 0, -1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pairs, unmatched, ok := stableRoommates(tt.prefs)
+			if tt.want == nil {
+				if ok {
+					t.Fatalf("got pairs %v, want no stable matching", pairs)
+				}
+				return
+			}
+			if !ok {
+				t.Fatalf("got no stable matching, want %v", tt.want)
+			}
+			if got := partners(t, tt.prefs, pairs, unmatched); !slices.Equal(got, tt.want) {
+				t.Errorf("got partners %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStableRoommatesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 1000; iter++ {
+		n := 1 + rng.Intn(8)
+		density := 0.3 + 0.7*rng.Float64()
+		prefs := make([][]int, n)
+		for i := 0; i < n; i++ {
+			for j := 0; j < i; j++ {
+				if rng.Float64() < density {
+					prefs[i] = append(prefs[i], j)
+					prefs[j] = append(prefs[j], i)
+				}
+			}
+		}
+		for _, list := range prefs {
+			rng.Shuffle(len(list), func(a, b int) { list[a], list[b] = list[b], list[a] })
+		}
+
+		stable := bruteForceStable(prefs)
+		pairs, unmatched, ok := stableRoommates(prefs)
+		if ok != (len(stable) > 0) {
+			t.Fatalf("prefs %v: got ok=%v, but brute force found %d stable matchings", prefs, ok, len(stable))
+		}
+		if !ok {
+			continue
+		}
+
+		partner := partners(t, prefs, pairs, unmatched)
+		if pair, blocked := blockingPair(prefs, partner); blocked {
+			t.Fatalf("prefs %v: matching %v is blocked by %v", prefs, partner, pair)
+		}
+		// Every stable matching leaves the same members unmatched
+		for i := range partner {
+			if (partner[i] == -1) != (stable[0][i] == -1) {
+				t.Fatalf("prefs %v: got %v, but stable matching %v matches a different set of members", prefs, partner, stable[0])
+			}
+		}
+	}
+}
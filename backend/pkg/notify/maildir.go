@@ -0,0 +1,49 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+)
+
+// maildirSeq keeps file names unique within a process
+var maildirSeq atomic.Int64
+
+// MaildirMailer writes each email to a maildir instead of sending it, so that
+// emails can be checked during development with any mail client or text editor
+type MaildirMailer struct {
+	Dir string
+}
+
+// Send returns the Message-ID header of the email
+func (m *MaildirMailer) Send(ctx context.Context, msg Message) (string, error) {
+	for _, sub := range []string{"tmp", "new", "cur"} {
+		if err := os.MkdirAll(filepath.Join(m.Dir, sub), 0o755); err != nil {
+			return "", fmt.Errorf("could not create maildir: %w", err)
+		}
+	}
+	messageID, err := newMessageID(msg.From)
+	if err != nil {
+		return "", fmt.Errorf("could not create message ID: %w", err)
+	}
+
+	host, err := os.Hostname()
+	if err != nil {
+		host = "localhost"
+	}
+	name := fmt.Sprintf("%d.%d_%d.%s", time.Now().Unix(), os.Getpid(), maildirSeq.Add(1), host)
+
+	// Messages are written to tmp and then moved to new, so readers never see
+	// a partly written message
+	tmpPath := filepath.Join(m.Dir, "tmp", name)
+	if err := os.WriteFile(tmpPath, msg.bytes(messageID), 0o644); err != nil {
+		return "", fmt.Errorf("could not write email: %w", err)
+	}
+	if err := os.Rename(tmpPath, filepath.Join(m.Dir, "new", name)); err != nil {
+		return "", fmt.Errorf("could not deliver email: %w", err)
+	}
+	return messageID, nil
+}
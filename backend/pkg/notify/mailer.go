@@ -0,0 +1,119 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net/mail"
+	"os"
+	"strings"
+	"time"
+)
+
+// Names of the available mailer backends
+const (
+	// MailerSMTP sends through an SMTP server
+	MailerSMTP = "smtp"
+	// MailerMailgun sends through the Mailgun HTTP API or a compatible service
+	MailerMailgun = "mailgun"
+	// MailerMaildir writes emails to a local maildir instead of sending them,
+	// for development
+	MailerMaildir = "maildir"
+)
+
+// Message is a plain text email
+type Message struct {
+	From    string
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers emails
+type Mailer interface {
+	// Send delivers the message and returns the ID the backend assigned to it
+	Send(ctx context.Context, msg Message) (string, error)
+}
+
+// MailerNames returns the names of all mailer backends
+func MailerNames() []string {
+	return []string{MailerSMTP, MailerMailgun, MailerMaildir}
+}
+
+// NewMailerFromEnv creates the named backend, configured by environment variables:
+// SMTP_ADDR, SMTP_USERNAME and SMTP_PASSWORD for SMTP, MAILGUN_DOMAIN,
+// MAILGUN_API_KEY and optionally MAILGUN_API_URL for Mailgun, and MAILDIR
+// (default "sent_emails") for the maildir.
+func NewMailerFromEnv(name string) (Mailer, error) {
+	switch name {
+	case MailerSMTP:
+		addr := os.Getenv("SMTP_ADDR")
+		if addr == "" {
+			return nil, fmt.Errorf("SMTP_ADDR environment variable not set")
+		}
+		return &SMTPMailer{
+			Addr:     addr,
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+		}, nil
+	case MailerMailgun:
+		domain := os.Getenv("MAILGUN_DOMAIN")
+		if domain == "" {
+			return nil, fmt.Errorf("MAILGUN_DOMAIN environment variable not set")
+		}
+		apiKey := os.Getenv("MAILGUN_API_KEY")
+		if apiKey == "" {
+			return nil, fmt.Errorf("MAILGUN_API_KEY environment variable not set")
+		}
+		return &MailgunMailer{
+			APIURL: os.Getenv("MAILGUN_API_URL"),
+			Domain: domain,
+			APIKey: apiKey,
+		}, nil
+	case MailerMaildir:
+		dir := os.Getenv("MAILDIR")
+		if dir == "" {
+			dir = "sent_emails"
+		}
+		return &MaildirMailer{Dir: dir}, nil
+	default:
+		return nil, fmt.Errorf("unknown mailer %q, must be one of %v", name, MailerNames())
+	}
+}
+
+// newMessageID returns a unique Message-ID in the sender's domain
+func newMessageID(from string) (string, error) {
+	domain := "localhost"
+	if addr, err := mail.ParseAddress(from); err == nil {
+		if _, d, ok := strings.Cut(addr.Address, "@"); ok {
+			domain = d
+		}
+	}
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(b), domain), nil
+}
+
+// bytes formats the message as an RFC 5322 email with UTF-8 plain text content
+func (m Message) bytes(messageID string) []byte {
+	var b bytes.Buffer
+	header := func(name, value string) {
+		fmt.Fprintf(&b, "%s: %s\r\n", name, value)
+	}
+	header("Message-ID", messageID)
+	header("From", m.From)
+	header("To", m.To)
+	header("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+	header("Content-Type", `text/plain; charset="utf-8"`)
+	header("Content-Transfer-Encoding", "8bit")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(m.Body, "\r\n", "\n"), "\n", "\r\n"))
+	return b.Bytes()
+}
@@ -0,0 +1,73 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const mailgunAPIURL = "https://api.mailgun.net/v3"
+
+// MailgunMailer sends emails through the Mailgun messages API, or any service
+// compatible with it
+type MailgunMailer struct {
+	// APIURL defaults to Mailgun's US region; EU domains use https://api.eu.mailgun.net/v3
+	APIURL string
+	Domain string
+	APIKey string
+	// Client defaults to http.DefaultClient
+	Client *http.Client
+}
+
+// Send returns the message ID from the API's response
+func (m *MailgunMailer) Send(ctx context.Context, msg Message) (string, error) {
+	apiURL := m.APIURL
+	if apiURL == "" {
+		apiURL = mailgunAPIURL
+	}
+	client := m.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	data := url.Values{}
+	data.Set("from", msg.From)
+	data.Set("to", msg.To)
+	data.Set("subject", msg.Subject)
+	data.Set("text", msg.Body)
+
+	endpoint := strings.TrimSuffix(apiURL, "/") + "/" + url.PathEscape(m.Domain) + "/messages"
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(data.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("could not create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth("api", m.APIKey)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("could not make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("could not read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("mailgun API returned non-200 status code: %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("could not unmarshal response body: %w", err)
+	}
+	return result.ID, nil
+}
@@ -0,0 +1,236 @@
+package notify
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/sukhmai/spotify-match/pkg/db"
+	"github.com/sukhmai/spotify-match/pkg/token"
+)
+
+// defaultMatchTemplate introduces a user to their match and asks whether they
+// want to share contact details
+//
+//go:embed match_email.tmpl
+var defaultMatchTemplate string
+
+// defaultContactTemplate shares the contact details of a match once both users accepted
+//
+//go:embed contact_email.tmpl
+var defaultContactTemplate string
+
+// defaultMatchSMSTemplate and defaultContactSMSTemplate are the text message
+// versions, which link to the match page instead of including the details
+//
+//go:embed match_sms.tmpl
+var defaultMatchSMSTemplate string
+
+//go:embed contact_sms.tmpl
+var defaultContactSMSTemplate string
+
+// MatchEmail is the data available to match email templates, for one of the
+// two users of a match
+type MatchEmail struct {
+	MatchID   int
+	UserID    int
+	FirstName string
+	LastName  string
+	Email     string
+	Phone     string
+	// Channels are the channels the user wants to be notified on
+	Channels       []string
+	MatchFirstName string
+	MatchLastName  string
+	// Contact is the partner's contact details, which are only set in the
+	// emails built by NewContactEmails for when both users accepted
+	Contact       *Contact
+	Similarity    float64
+	MatchScore    int
+	CommonArtists []string
+	// ResponseURL links the user to the page where they accept or decline
+	// sharing contact details, and AcceptURL and DeclineURL open it with
+	// that answer selected
+	ResponseURL string
+	AcceptURL   string
+	DeclineURL  string
+	// FeedbackURL links the user to the feedback page, if there is one
+	FeedbackURL string
+}
+
+// Contact is how to reach a user's partner
+type Contact struct {
+	Email string
+	Phone string
+}
+
+// Links signs each user's tokens and adds them to the URLs of the pages they
+// are used on. Links to pages without a URL are left empty.
+type Links struct {
+	Signer      *token.Signer
+	ResponseURL string
+	FeedbackURL string
+}
+
+// DefaultMatchTemplate returns the built-in match email template
+func DefaultMatchTemplate() *template.Template {
+	return template.Must(template.New("match_email").Parse(defaultMatchTemplate))
+}
+
+// DefaultContactTemplate returns the built-in template for sharing contact details
+func DefaultContactTemplate() *template.Template {
+	return template.Must(template.New("contact_email").Parse(defaultContactTemplate))
+}
+
+// DefaultMatchSMSTemplate returns the built-in match text template
+func DefaultMatchSMSTemplate() *template.Template {
+	return template.Must(template.New("match_sms").Parse(defaultMatchSMSTemplate))
+}
+
+// DefaultContactSMSTemplate returns the built-in text template for when both users accepted
+func DefaultContactSMSTemplate() *template.Template {
+	return template.Must(template.New("contact_sms").Parse(defaultContactSMSTemplate))
+}
+
+// LoadMatchTemplate parses a match email template from a file
+func LoadMatchTemplate(path string) (*template.Template, error) {
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := template.New("match_email").Parse(string(text))
+	if err != nil {
+		return nil, fmt.Errorf("invalid template %s: %w", path, err)
+	}
+	return tmpl, nil
+}
+
+// fill adds the user's links of the given generation to their email
+func (l *Links) fill(email *MatchEmail, generation int) error {
+	if l == nil || l.Signer == nil {
+		return nil
+	}
+	t := token.MatchToken{MatchID: email.MatchID, UserID: email.UserID, Generation: generation}
+	if l.ResponseURL != "" {
+		responseToken, err := l.Signer.SignResponse(t)
+		if err != nil {
+			return err
+		}
+		email.ResponseURL = withQuery(l.ResponseURL, url.Values{"token": {responseToken}})
+		email.AcceptURL = withQuery(l.ResponseURL, url.Values{"token": {responseToken}, "answer": {"accept"}})
+		email.DeclineURL = withQuery(l.ResponseURL, url.Values{"token": {responseToken}, "answer": {"decline"}})
+	}
+	if l.FeedbackURL != "" {
+		feedbackToken, err := l.Signer.SignFeedback(t)
+		if err != nil {
+			return err
+		}
+		email.FeedbackURL = withQuery(l.FeedbackURL, url.Values{"token": {feedbackToken}})
+	}
+	return nil
+}
+
+// withQuery adds query parameters to a URL that may already have some
+func withQuery(base string, values url.Values) string {
+	sep := "?"
+	if strings.Contains(base, "?") {
+		sep = "&"
+	}
+	return base + sep + values.Encode()
+}
+
+// NewMatchEmails builds the emails for both users of a match. Users maps user
+// IDs to users and must contain both of them.
+func NewMatchEmails(m db.Match, users map[int]db.User, commonArtists []string, links *Links) ([]MatchEmail, error) {
+	emails := make([]MatchEmail, 0, 2)
+	for _, userID := range []int{m.UserAID, m.UserBID} {
+		user, ok := users[userID]
+		if !ok {
+			return nil, fmt.Errorf("user %d of match %d not found", userID, m.ID)
+		}
+		partner, ok := users[m.PartnerID(userID)]
+		if !ok {
+			return nil, fmt.Errorf("user %d of match %d not found", m.PartnerID(userID), m.ID)
+		}
+		email := MatchEmail{
+			MatchID:        m.ID,
+			UserID:         userID,
+			FirstName:      user.FirstName,
+			LastName:       user.LastName,
+			Email:          user.Email,
+			Phone:          user.PhoneNumber,
+			Channels:       user.NotificationChannels,
+			MatchFirstName: partner.FirstName,
+			MatchLastName:  partner.LastName,
+			Similarity:     m.Similarity,
+			MatchScore:     m.MatchScore,
+			CommonArtists:  commonArtists,
+		}
+		if err := links.fill(&email, m.LinkGeneration(userID)); err != nil {
+			return nil, fmt.Errorf("failed to sign link tokens: %w", err)
+		}
+		emails = append(emails, email)
+	}
+	return emails, nil
+}
+
+// NewContactEmails builds the emails for both users of a match that include
+// their partner's contact details, to be sent once both of them accepted
+func NewContactEmails(m db.Match, users map[int]db.User, commonArtists []string, links *Links) ([]MatchEmail, error) {
+	emails, err := NewMatchEmails(m, users, commonArtists, links)
+	if err != nil {
+		return nil, err
+	}
+	for i := range emails {
+		partner := users[m.PartnerID(emails[i].UserID)]
+		emails[i].Contact = &Contact{Email: partner.Email, Phone: partner.PhoneNumber}
+	}
+	return emails, nil
+}
+
+// LoadMatchEmails builds the emails for both users of every stored match of a round
+func LoadMatchEmails(ctx context.Context, dbClient *db.DBClient, roundID int, links *Links) ([]MatchEmail, error) {
+	matches, err := dbClient.GetRoundMatches(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+
+	roundUsers, err := dbClient.GetRoundUsers(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+	users := make(map[int]db.User, len(roundUsers))
+	for _, u := range roundUsers {
+		users[u.ID] = u
+	}
+
+	var artistIDs []int
+	for _, m := range matches {
+		artistIDs = append(artistIDs, m.SharedArtistIDs...)
+	}
+	artists, err := dbClient.GetArtistsByInternalIDs(ctx, artistIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var emails []MatchEmail
+	for _, m := range matches {
+		var names []string
+		for _, artistID := range m.SharedArtistIDs {
+			if artist, ok := artists[artistID]; ok {
+				names = append(names, artist.Name)
+			}
+		}
+
+		matchEmails, err := NewMatchEmails(m, users, names, links)
+		if err != nil {
+			return nil, err
+		}
+		emails = append(emails, matchEmails...)
+	}
+	return emails, nil
+}
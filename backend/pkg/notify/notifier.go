@@ -0,0 +1,70 @@
+package notify
+
+import (
+	"fmt"
+	"net/mail"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// Notifier renders match emails, which are enqueued in the outbox and
+// delivered by a Worker
+type Notifier struct {
+	// Mailer delivers verification emails, which are sent right away
+	Mailer   Mailer
+	Template *template.Template
+	// Name identifies the template in the outbox, so that each user gets at
+	// most one message per match from it on each channel
+	Name string
+	// Channel is the channel the messages are sent on, email by default
+	Channel string
+	// From is the sender address, e.g. "Music Match <matches@example.com>",
+	// or the phone number texts are sent from
+	From    string
+	Subject string
+}
+
+// Render fills in the template for one user's match email. Texts are rendered
+// into a message without a subject, addressed to the user's phone number.
+func (n *Notifier) Render(email MatchEmail) (Message, error) {
+	var body strings.Builder
+	if err := n.Template.Execute(&body, email); err != nil {
+		return Message{}, fmt.Errorf("failed to render message to %s: %w", email.Email, err)
+	}
+	if n.channel() == ChannelSMS {
+		to, err := PhoneNumber(email.Phone)
+		if err != nil {
+			return Message{}, fmt.Errorf("failed to render text to %s: %w", email.Email, err)
+		}
+		return Message{
+			From: n.From,
+			To:   to,
+			Body: strings.TrimSpace(body.String()),
+		}, nil
+	}
+	to := (&mail.Address{Name: strings.TrimSpace(email.FirstName + " " + email.LastName), Address: email.Email}).String()
+	return Message{
+		From:    n.From,
+		To:      to,
+		Subject: n.Subject,
+		Body:    body.String(),
+	}, nil
+}
+
+// channel returns the channel the messages are sent on
+func (n *Notifier) channel() string {
+	if n.Channel == "" {
+		return ChannelEmail
+	}
+	return n.Channel
+}
+
+// wanted reports whether the user wants to be notified on the notifier's
+// channel. Users without preferences get emails only.
+func (n *Notifier) wanted(email MatchEmail) bool {
+	if len(email.Channels) == 0 {
+		return n.channel() == ChannelEmail
+	}
+	return slices.Contains(email.Channels, n.channel())
+}
@@ -0,0 +1,240 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/sukhmai/spotify-match/pkg/db"
+)
+
+// Channels notifications are delivered through
+const (
+	ChannelEmail = "email"
+	ChannelSMS   = "sms"
+)
+
+// ChannelNames returns the names of all channels
+func ChannelNames() []string {
+	return []string{ChannelEmail, ChannelSMS}
+}
+
+// Templates notifications are rendered from
+const (
+	// TemplateMatch tells a user who they were matched with
+	TemplateMatch = "match"
+	// TemplateContact shares contact details once both users of a match accepted
+	TemplateContact = "contact"
+)
+
+// Worker defaults
+const (
+	DefaultBatchSize   = 50
+	DefaultMaxAttempts = 5
+	DefaultBackoff     = time.Minute
+	DefaultMaxBackoff  = time.Hour
+	DefaultLease       = 10 * time.Minute
+)
+
+// Enqueue renders match emails and adds them to the outbox for a worker to
+// deliver. Emails already in the outbox are skipped, so enqueueing a round
+// again only adds the emails that are missing. It returns how many were added.
+func (n *Notifier) Enqueue(ctx context.Context, dbClient *db.DBClient, emails []MatchEmail) (int, error) {
+	notifications, err := n.Notifications(emails)
+	if err != nil {
+		return 0, err
+	}
+	return dbClient.EnqueueNotifications(ctx, notifications)
+}
+
+// Notifications renders emails into notifications for the outbox, skipping
+// users who don't want to be notified on the notifier's channel
+func (n *Notifier) Notifications(emails []MatchEmail) ([]db.Notification, error) {
+	if n.Name == "" {
+		return nil, errors.New("notifier has no template name")
+	}
+	var notifications []db.Notification
+	for _, email := range emails {
+		if !n.wanted(email) {
+			continue
+		}
+		msg, err := n.Render(email)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, db.Notification{
+			UserID:    email.UserID,
+			MatchID:   email.MatchID,
+			Channel:   n.channel(),
+			Template:  n.Name,
+			Sender:    msg.From,
+			Recipient: msg.To,
+			Subject:   msg.Subject,
+			Body:      msg.Body,
+		})
+	}
+	return notifications, nil
+}
+
+// Outbox is the queue of notifications a Worker delivers, implemented by
+// db.DBClient
+type Outbox interface {
+	ClaimNotifications(ctx context.Context, limit int) ([]db.Notification, error)
+	MarkNotificationSent(ctx context.Context, notificationID int, providerMessageID string) error
+	RetryNotification(ctx context.Context, notificationID int, deliveryErr string, delay time.Duration) error
+	FailNotification(ctx context.Context, notificationID int, deliveryErr string) error
+	FailAbandonedNotifications(ctx context.Context, lease time.Duration) (int, error)
+}
+
+// Worker delivers the notifications in the outbox. Failed deliveries are
+// retried with exponential backoff until MaxAttempts is reached. A
+// notification is marked as sending before it is handed to the mailer, so
+// one whose worker stopped before recording the outcome is never sent again
+// automatically; see db.FailAbandonedNotifications.
+type Worker struct {
+	DB     Outbox
+	Mailer Mailer
+	// SMS sends notifications on the SMS channel; without it they fail
+	SMS SMSSender
+	// BatchSize is how many notifications are claimed at a time
+	BatchSize int
+	// MaxAttempts is how many times delivery is attempted before giving up
+	MaxAttempts int
+	// Backoff is the delay after the first failed attempt, doubled after each
+	// further failure up to MaxBackoff
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// Lease is how long a notification may stay sending before it is
+	// considered abandoned
+	Lease time.Duration
+}
+
+// withDefaults returns the worker with unset fields filled in
+func (w Worker) withDefaults() Worker {
+	if w.BatchSize <= 0 {
+		w.BatchSize = DefaultBatchSize
+	}
+	if w.MaxAttempts <= 0 {
+		w.MaxAttempts = DefaultMaxAttempts
+	}
+	if w.Backoff <= 0 {
+		w.Backoff = DefaultBackoff
+	}
+	if w.MaxBackoff <= 0 {
+		w.MaxBackoff = DefaultMaxBackoff
+	}
+	if w.Lease <= 0 {
+		w.Lease = DefaultLease
+	}
+	return w
+}
+
+// RetryDelay returns how long to wait before retrying after the given number
+// of failed attempts
+func (w Worker) RetryDelay(attempts int) time.Duration {
+	w = w.withDefaults()
+	delay := w.Backoff
+	for i := 1; i < attempts && delay < w.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, w.MaxBackoff)
+}
+
+// Drain delivers notifications until none are due, returning how many were
+// sent and how many failed for good. Notifications waiting to be retried are
+// left for a later run.
+func (w Worker) Drain(ctx context.Context) (sent, failed int, err error) {
+	w = w.withDefaults()
+
+	abandoned, err := w.DB.FailAbandonedNotifications(ctx, w.Lease)
+	if err != nil {
+		return 0, 0, err
+	}
+	if abandoned > 0 {
+		log.Printf("Marked %d notifications abandoned while sending as failed, they may have been delivered", abandoned)
+	}
+
+	for {
+		notifications, err := w.DB.ClaimNotifications(ctx, w.BatchSize)
+		if err != nil {
+			return sent, failed, err
+		}
+		if len(notifications) == 0 {
+			return sent, failed, nil
+		}
+		for _, n := range notifications {
+			ok, err := w.deliver(ctx, n)
+			if err != nil {
+				return sent, failed, err
+			}
+			if ok {
+				sent++
+			} else if n.Attempts >= w.MaxAttempts {
+				failed++
+			}
+		}
+	}
+}
+
+// Run drains the outbox every interval until the context is cancelled
+func (w Worker) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		sent, failed, err := w.Drain(ctx)
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+		if sent > 0 || failed > 0 {
+			log.Printf("Sent %d notifications, %d failed", sent, failed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliver sends a claimed notification and records the outcome, reporting
+// whether it was sent. Errors are only returned when the outcome could not be
+// recorded.
+func (w Worker) deliver(ctx context.Context, n db.Notification) (bool, error) {
+	var messageID string
+	var sendErr error
+	switch {
+	case n.Channel == ChannelEmail:
+		messageID, sendErr = w.Mailer.Send(ctx, Message{
+			From:    n.Sender,
+			To:      n.Recipient,
+			Subject: n.Subject,
+			Body:    n.Body,
+		})
+	case n.Channel == ChannelSMS && w.SMS != nil:
+		messageID, sendErr = w.SMS.Send(ctx, SMS{
+			From: n.Sender,
+			To:   n.Recipient,
+			Body: n.Body,
+		})
+	case n.Channel == ChannelSMS:
+		sendErr = errors.New("no SMS provider configured")
+	default:
+		sendErr = fmt.Errorf("unsupported channel %q", n.Channel)
+	}
+
+	// Record the outcome even if the worker is being stopped, so that a sent
+	// notification is not left looking abandoned
+	ctx = context.WithoutCancel(ctx)
+	if sendErr == nil {
+		return true, w.DB.MarkNotificationSent(ctx, n.ID, messageID)
+	}
+
+	log.Printf("Attempt %d to send notification %d to %s failed: %v", n.Attempts, n.ID, n.Recipient, sendErr)
+	if n.Attempts >= w.MaxAttempts {
+		return false, w.DB.FailNotification(ctx, n.ID, sendErr.Error())
+	}
+	return false, w.DB.RetryNotification(ctx, n.ID, sendErr.Error(), w.RetryDelay(n.Attempts))
+}
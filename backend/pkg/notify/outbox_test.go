@@ -0,0 +1,270 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sukhmai/spotify-match/pkg/db"
+)
+
+// memoryOutbox is an outbox kept in memory, with a clock that only moves when
+// the test advances it
+type memoryOutbox struct {
+	now     time.Duration
+	entries []*outboxEntry
+}
+
+type outboxEntry struct {
+	db.Notification
+	due time.Duration
+	// delays are the retry delays the worker asked for
+	delays []time.Duration
+}
+
+func newMemoryOutbox(notifications ...db.Notification) *memoryOutbox {
+	o := &memoryOutbox{}
+	for i, n := range notifications {
+		n.ID = i + 1
+		n.Status = db.NotificationStatusPending
+		o.entries = append(o.entries, &outboxEntry{Notification: n})
+	}
+	return o
+}
+
+func (o *memoryOutbox) entry(notificationID int) *outboxEntry {
+	return o.entries[notificationID-1]
+}
+
+func (o *memoryOutbox) ClaimNotifications(ctx context.Context, limit int) ([]db.Notification, error) {
+	var claimed []db.Notification
+	for _, e := range o.entries {
+		if len(claimed) == limit {
+			break
+		}
+		if e.Status == db.NotificationStatusPending && e.due <= o.now {
+			e.Status = db.NotificationStatusSending
+			e.Attempts++
+			claimed = append(claimed, e.Notification)
+		}
+	}
+	return claimed, nil
+}
+
+func (o *memoryOutbox) MarkNotificationSent(ctx context.Context, notificationID int, providerMessageID string) error {
+	e := o.entry(notificationID)
+	e.Status = db.NotificationStatusSent
+	e.ProviderMessageID = providerMessageID
+	e.LastError = ""
+	return nil
+}
+
+func (o *memoryOutbox) RetryNotification(ctx context.Context, notificationID int, deliveryErr string, delay time.Duration) error {
+	e := o.entry(notificationID)
+	e.Status = db.NotificationStatusPending
+	e.LastError = deliveryErr
+	e.due = o.now + delay
+	e.delays = append(e.delays, delay)
+	return nil
+}
+
+func (o *memoryOutbox) FailNotification(ctx context.Context, notificationID int, deliveryErr string) error {
+	e := o.entry(notificationID)
+	e.Status = db.NotificationStatusFailed
+	e.LastError = deliveryErr
+	return nil
+}
+
+func (o *memoryOutbox) FailAbandonedNotifications(ctx context.Context, lease time.Duration) (int, error) {
+	return 0, nil
+}
+
+// flakyMailer fails the first sends and hands the rest to the wrapped mailer
+type flakyMailer struct {
+	Mailer
+	failures int
+}
+
+func (m *flakyMailer) Send(ctx context.Context, msg Message) (string, error) {
+	if m.failures > 0 {
+		m.failures--
+		return "", errors.New("connection refused")
+	}
+	return m.Mailer.Send(ctx, msg)
+}
+
+func emailNotification(to string) db.Notification {
+	return db.Notification{
+		Channel:   ChannelEmail,
+		Template:  TemplateMatch,
+		Sender:    "matches@example.com",
+		Recipient: to,
+		Subject:   "You have a match",
+		Body:      "Say hello",
+	}
+}
+
+// drain runs the worker once and checks how many notifications it sent and
+// gave up on
+func drain(t *testing.T, w Worker, wantSent, wantFailed int) {
+	t.Helper()
+	sent, failed, err := w.Drain(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sent != wantSent || failed != wantFailed {
+		t.Fatalf("Drain() = %d sent, %d failed, want %d sent, %d failed", sent, failed, wantSent, wantFailed)
+	}
+}
+
+func TestWorkerSends(t *testing.T) {
+	dir := t.TempDir()
+	sms := &FakeSMSSender{}
+	outbox := newMemoryOutbox(
+		emailNotification("ana@example.com"),
+		db.Notification{Channel: ChannelSMS, Template: TemplateMatch, Sender: "+15550000000", Recipient: "+15551234567", Body: "Say hello"},
+	)
+	w := Worker{DB: outbox, Mailer: &MaildirMailer{Dir: dir}, SMS: sms, BatchSize: 1}
+
+	drain(t, w, 2, 0)
+
+	for _, e := range outbox.entries {
+		if e.Status != db.NotificationStatusSent || e.Attempts != 1 || e.ProviderMessageID == "" {
+			t.Errorf("notification %d is %s after %d attempts with message ID %q, want sent after 1",
+				e.ID, e.Status, e.Attempts, e.ProviderMessageID)
+		}
+	}
+	files, err := os.ReadDir(filepath.Join(dir, "new"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("got %d emails in the maildir, want 1", len(files))
+	}
+	email, err := os.ReadFile(filepath.Join(dir, "new", files[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(email), "To: ana@example.com\r\n") {
+		t.Errorf("email is not addressed to the recipient:\n%s", email)
+	}
+	if len(sms.Sent) != 1 || sms.Sent[0].To != "+15551234567" || sms.Sent[0].Body != "Say hello" {
+		t.Errorf("got texts %+v, want one to +15551234567", sms.Sent)
+	}
+
+	// Nothing is left to send
+	drain(t, w, 0, 0)
+}
+
+func TestWorkerRetries(t *testing.T) {
+	mailer := &flakyMailer{Mailer: &MaildirMailer{Dir: t.TempDir()}, failures: 2}
+	outbox := newMemoryOutbox(emailNotification("ana@example.com"))
+	w := Worker{DB: outbox, Mailer: mailer, MaxAttempts: 5, Backoff: time.Minute}
+	e := outbox.entry(1)
+
+	drain(t, w, 0, 0)
+	if e.Status != db.NotificationStatusPending || e.LastError != "connection refused" {
+		t.Fatalf("after a failed attempt the notification is %s with error %q, want pending", e.Status, e.LastError)
+	}
+
+	// The retry is not due until the backoff has passed
+	outbox.now += 59 * time.Second
+	drain(t, w, 0, 0)
+	outbox.now += time.Second
+	drain(t, w, 0, 0)
+
+	// The backoff doubles after the second failure
+	outbox.now += 2 * time.Minute
+	drain(t, w, 1, 0)
+
+	if e.Status != db.NotificationStatusSent || e.Attempts != 3 || e.LastError != "" {
+		t.Errorf("notification is %s after %d attempts with error %q, want sent after 3 with no error",
+			e.Status, e.Attempts, e.LastError)
+	}
+	if want := []time.Duration{time.Minute, 2 * time.Minute}; !slices.Equal(e.delays, want) {
+		t.Errorf("got retry delays %v, want %v", e.delays, want)
+	}
+}
+
+func TestWorkerFails(t *testing.T) {
+	tests := []struct {
+		name         string
+		notification db.Notification
+		wantErr      string
+	}{
+		{
+			name:         "mailer keeps failing",
+			notification: emailNotification("ana@example.com"),
+			wantErr:      "connection refused",
+		},
+		{
+			name:         "no SMS provider",
+			notification: db.Notification{Channel: ChannelSMS, Recipient: "+15551234567", Body: "Say hello"},
+			wantErr:      "no SMS provider configured",
+		},
+		{
+			name:         "unknown channel",
+			notification: db.Notification{Channel: "pigeon", Recipient: "ana", Body: "Say hello"},
+			wantErr:      `unsupported channel "pigeon"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mailer := &flakyMailer{Mailer: &MaildirMailer{Dir: t.TempDir()}, failures: 10}
+			outbox := newMemoryOutbox(tt.notification)
+			w := Worker{DB: outbox, Mailer: mailer, MaxAttempts: 3, Backoff: time.Minute, MaxBackoff: 90 * time.Second}
+			e := outbox.entry(1)
+
+			for attempt := 1; attempt < 3; attempt++ {
+				drain(t, w, 0, 0)
+				if e.Status != db.NotificationStatusPending {
+					t.Fatalf("after attempt %d the notification is %s, want pending", attempt, e.Status)
+				}
+				outbox.now += time.Hour
+			}
+			drain(t, w, 0, 1)
+
+			if e.Status != db.NotificationStatusFailed || e.Attempts != 3 || e.LastError != tt.wantErr {
+				t.Errorf("notification is %s after %d attempts with error %q, want failed after 3 with error %q",
+					e.Status, e.Attempts, e.LastError, tt.wantErr)
+			}
+			if want := []time.Duration{time.Minute, 90 * time.Second}; !slices.Equal(e.delays, want) {
+				t.Errorf("got retry delays %v, want %v", e.delays, want)
+			}
+
+			// A failed notification is not tried again
+			outbox.now += time.Hour
+			drain(t, w, 0, 0)
+			if e.Attempts != 3 {
+				t.Errorf("got %d attempts, want 3", e.Attempts)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	w := Worker{Backoff: time.Minute, MaxBackoff: 10 * time.Minute}
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Minute},
+		{attempts: 2, want: 2 * time.Minute},
+		{attempts: 4, want: 8 * time.Minute},
+		{attempts: 5, want: 10 * time.Minute},
+		{attempts: 100, want: 10 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := w.RetryDelay(tt.attempts); got != tt.want {
+			t.Errorf("RetryDelay(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+	if got := (Worker{}).RetryDelay(1); got != DefaultBackoff {
+		t.Errorf("RetryDelay(1) without a backoff = %v, want %v", got, DefaultBackoff)
+	}
+}
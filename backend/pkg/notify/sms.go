@@ -0,0 +1,133 @@
+package notify
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Names of the available SMS providers
+const (
+	// SMSTwilio sends through the Twilio messages API or a compatible service
+	SMSTwilio = "twilio"
+	// SMSFake records texts to a local file instead of sending them, for development
+	SMSFake = "fake"
+)
+
+// SMS is a text message
+type SMS struct {
+	From string
+	To   string
+	Body string
+}
+
+// SMSSender delivers text messages
+type SMSSender interface {
+	// Send delivers the text and returns the ID the provider assigned to it
+	Send(ctx context.Context, sms SMS) (string, error)
+}
+
+// SMSSenderNames returns the names of all SMS providers
+func SMSSenderNames() []string {
+	return []string{SMSTwilio, SMSFake}
+}
+
+// NewSMSSenderFromEnv creates the named provider, configured by environment
+// variables: TWILIO_ACCOUNT_SID, TWILIO_AUTH_TOKEN and optionally
+// TWILIO_API_URL for Twilio, and SMS_LOG (default "sent_sms.jsonl") for the fake.
+func NewSMSSenderFromEnv(name string) (SMSSender, error) {
+	switch name {
+	case SMSTwilio:
+		accountSID := os.Getenv("TWILIO_ACCOUNT_SID")
+		if accountSID == "" {
+			return nil, fmt.Errorf("TWILIO_ACCOUNT_SID environment variable not set")
+		}
+		authToken := os.Getenv("TWILIO_AUTH_TOKEN")
+		if authToken == "" {
+			return nil, fmt.Errorf("TWILIO_AUTH_TOKEN environment variable not set")
+		}
+		return &TwilioSender{
+			APIURL:     os.Getenv("TWILIO_API_URL"),
+			AccountSID: accountSID,
+			AuthToken:  authToken,
+		}, nil
+	case SMSFake:
+		path := os.Getenv("SMS_LOG")
+		if path == "" {
+			path = "sent_sms.jsonl"
+		}
+		return &FakeSMSSender{Path: path}, nil
+	default:
+		return nil, fmt.Errorf("unknown SMS provider %q, must be one of %v", name, SMSSenderNames())
+	}
+}
+
+// FakeSMSSender records texts instead of sending them. Each text is kept in
+// Sent and, if Path is set, appended to that file as a line of JSON.
+type FakeSMSSender struct {
+	Path string
+
+	mu   sync.Mutex
+	Sent []SMS
+}
+
+// Send returns a random ID for the recorded text
+func (f *FakeSMSSender) Send(ctx context.Context, sms SMS) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("could not create message ID: %w", err)
+	}
+	id := "fake-" + hex.EncodeToString(b)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.Path != "" {
+		line, err := json.Marshal(struct {
+			ID   string `json:"id"`
+			From string `json:"from"`
+			To   string `json:"to"`
+			Body string `json:"body"`
+		}{id, sms.From, sms.To, sms.Body})
+		if err != nil {
+			return "", fmt.Errorf("could not encode text: %w", err)
+		}
+		file, err := os.OpenFile(f.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return "", fmt.Errorf("could not open SMS log: %w", err)
+		}
+		defer file.Close()
+		if _, err := file.Write(append(line, '\n')); err != nil {
+			return "", fmt.Errorf("could not write text: %w", err)
+		}
+	}
+	f.Sent = append(f.Sent, sms)
+	return id, nil
+}
+
+// PhoneNumber converts a phone number as entered at signup to E.164 format.
+// Numbers without a country code are taken to be North American, matching the
+// 10 digit numbers the signup form asks for.
+func PhoneNumber(number string) (string, error) {
+	var digits strings.Builder
+	for _, r := range number {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		}
+	}
+	d := digits.String()
+	switch {
+	case strings.HasPrefix(strings.TrimSpace(number), "+") && len(d) >= 8 && len(d) <= 15:
+		return "+" + d, nil
+	case len(d) == 10:
+		return "+1" + d, nil
+	case len(d) == 11 && d[0] == '1':
+		return "+" + d, nil
+	default:
+		return "", fmt.Errorf("invalid phone number %q", number)
+	}
+}
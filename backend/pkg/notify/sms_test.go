@@ -0,0 +1,32 @@
+package notify
+
+import "testing"
+
+func TestPhoneNumber(t *testing.T) {
+	tests := []struct {
+		number  string
+		want    string
+		wantErr bool
+	}{
+		{number: "5551234567", want: "+15551234567"},
+		{number: "(555) 123-4567", want: "+15551234567"},
+		{number: "555.123.4567", want: "+15551234567"},
+		{number: "1 555 123 4567", want: "+15551234567"},
+		{number: "+1 (555) 123-4567", want: "+15551234567"},
+		{number: "  +44 20 7946 0958 ", want: "+442079460958"},
+		{number: "+49 30 1234", want: "+49301234"},
+		{number: "", wantErr: true},
+		{number: "not a number", wantErr: true},
+		{number: "555-1234", wantErr: true},
+		// Eleven digits are only a North American number with its country code
+		{number: "25551234567", wantErr: true},
+		{number: "+1234567", wantErr: true},
+		{number: "+1234567890123456", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := PhoneNumber(tt.number)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("PhoneNumber(%q) = %q, %v, want %q, error %v", tt.number, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+)
+
+// SMTPMailer sends emails through an SMTP server, authenticating with PLAIN
+// auth when a username is set
+type SMTPMailer struct {
+	// Addr is the server's host:port
+	Addr     string
+	Username string
+	Password string
+}
+
+// Send returns the Message-ID header of the email, since SMTP servers do not
+// report an ID of their own
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) (string, error) {
+	from, err := mail.ParseAddress(msg.From)
+	if err != nil {
+		return "", fmt.Errorf("invalid sender address: %w", err)
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return "", fmt.Errorf("invalid recipient address: %w", err)
+	}
+
+	var auth smtp.Auth
+	if m.Username != "" {
+		host, _, err := net.SplitHostPort(m.Addr)
+		if err != nil {
+			return "", fmt.Errorf("invalid SMTP address: %w", err)
+		}
+		auth = smtp.PlainAuth("", m.Username, m.Password, host)
+	}
+
+	messageID, err := newMessageID(msg.From)
+	if err != nil {
+		return "", fmt.Errorf("could not create message ID: %w", err)
+	}
+	if err := smtp.SendMail(m.Addr, auth, from.Address, []string{to.Address}, msg.bytes(messageID)); err != nil {
+		return "", fmt.Errorf("could not send email: %w", err)
+	}
+	return messageID, nil
+}
@@ -0,0 +1,72 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const twilioAPIURL = "https://api.twilio.com"
+
+// TwilioSender sends texts through the Twilio messages API, or any service
+// compatible with it
+type TwilioSender struct {
+	// APIURL defaults to Twilio's API
+	APIURL     string
+	AccountSID string
+	AuthToken  string
+	// Client defaults to http.DefaultClient
+	Client *http.Client
+}
+
+// Send returns the message SID from the API's response
+func (t *TwilioSender) Send(ctx context.Context, sms SMS) (string, error) {
+	apiURL := t.APIURL
+	if apiURL == "" {
+		apiURL = twilioAPIURL
+	}
+	client := t.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	data := url.Values{}
+	data.Set("From", sms.From)
+	data.Set("To", sms.To)
+	data.Set("Body", sms.Body)
+
+	endpoint := strings.TrimSuffix(apiURL, "/") + "/2010-04-01/Accounts/" + url.PathEscape(t.AccountSID) + "/Messages.json"
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(data.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("could not create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(t.AccountSID, t.AuthToken)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("could not make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("could not read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("twilio API returned status code: %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		SID string `json:"sid"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("could not unmarshal response body: %w", err)
+	}
+	return result.SID, nil
+}
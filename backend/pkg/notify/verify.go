@@ -0,0 +1,51 @@
+package notify
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"net/mail"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// defaultVerificationTemplate asks a user who signed up to confirm their email
+//
+//go:embed verify_email.tmpl
+var defaultVerificationTemplate string
+
+// VerificationEmail is the data available to verification email templates
+type VerificationEmail struct {
+	FirstName string
+	LastName  string
+	Email     string
+	VerifyURL string
+	ExpiresAt time.Time
+}
+
+// DefaultVerificationTemplate returns the built-in verification email template
+func DefaultVerificationTemplate() *template.Template {
+	return template.Must(template.New("verify_email").Parse(defaultVerificationTemplate))
+}
+
+// SendVerification renders a verification email and delivers it right away.
+// It does not go through the outbox, since the user does not exist until they
+// have confirmed their email and the link should arrive while they wait for it.
+func (n *Notifier) SendVerification(ctx context.Context, email VerificationEmail) error {
+	var body strings.Builder
+	if err := n.Template.Execute(&body, email); err != nil {
+		return fmt.Errorf("failed to render verification email to %s: %w", email.Email, err)
+	}
+	to := (&mail.Address{Name: strings.TrimSpace(email.FirstName + " " + email.LastName), Address: email.Email}).String()
+	_, err := n.Mailer.Send(ctx, Message{
+		From:    n.From,
+		To:      to,
+		Subject: n.Subject,
+		Body:    body.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send verification email to %s: %w", email.Email, err)
+	}
+	return nil
+}
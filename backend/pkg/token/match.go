@@ -0,0 +1,76 @@
+package token
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Match tokens are sent to each matched user along with their match and let
+// them act on it without signing in. Feedback tokens submit feedback on the
+// match and response tokens accept or decline sharing contact details.
+const (
+	FeedbackKind = "feedback"
+	FeedbackTTL  = 60 * 24 * time.Hour
+	ResponseKind = "match_response"
+	ResponseTTL  = 30 * 24 * time.Hour
+)
+
+// MatchToken identifies the user of a match a token was issued to. Generation
+// is the user's link generation on the match when it was issued; links are
+// revoked by moving the match to a new generation.
+type MatchToken struct {
+	MatchID    int
+	UserID     int
+	Generation int
+}
+
+// SignFeedback returns a feedback token for the given user's match
+func (s *Signer) SignFeedback(t MatchToken) (string, error) {
+	return s.signMatchUser(FeedbackKind, t, FeedbackTTL)
+}
+
+// VerifyFeedback checks a feedback token and returns the match and user it was issued for
+func (s *Signer) VerifyFeedback(token string) (MatchToken, error) {
+	return s.verifyMatchUser(token, FeedbackKind)
+}
+
+// SignResponse returns a response token for the given user's match
+func (s *Signer) SignResponse(t MatchToken) (string, error) {
+	return s.signMatchUser(ResponseKind, t, ResponseTTL)
+}
+
+// VerifyResponse checks a response token and returns the match and user it was issued for
+func (s *Signer) VerifyResponse(token string) (MatchToken, error) {
+	return s.verifyMatchUser(token, ResponseKind)
+}
+
+// signMatchUser signs a token whose subject is "match:user", followed by
+// ":generation" after the first generation
+func (s *Signer) signMatchUser(kind string, t MatchToken, ttl time.Duration) (string, error) {
+	subject := fmt.Sprintf("%d:%d", t.MatchID, t.UserID)
+	if t.Generation != 0 {
+		subject += fmt.Sprintf(":%d", t.Generation)
+	}
+	return s.Sign(kind, subject, ttl)
+}
+
+func (s *Signer) verifyMatchUser(token, kind string) (MatchToken, error) {
+	claims, err := s.Verify(token, kind)
+	if err != nil {
+		return MatchToken{}, err
+	}
+	parts := strings.Split(claims.Subject, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return MatchToken{}, ErrInvalidToken
+	}
+	ids := make([]int, 3)
+	for i, part := range parts {
+		ids[i], err = strconv.Atoi(part)
+		if err != nil {
+			return MatchToken{}, ErrInvalidToken
+		}
+	}
+	return MatchToken{MatchID: ids[0], UserID: ids[1], Generation: ids[2]}, nil
+}
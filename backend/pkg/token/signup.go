@@ -0,0 +1,31 @@
+package token
+
+import (
+	"strconv"
+	"time"
+)
+
+// Signup tokens are emailed to users who signed up by selecting artists, and
+// confirm that the email address is theirs
+const (
+	SignupKind = "verify_email"
+	SignupTTL  = 24 * time.Hour
+)
+
+// SignSignup returns a verification token for the given pending signup
+func (s *Signer) SignSignup(signupID int) (string, error) {
+	return s.Sign(SignupKind, strconv.Itoa(signupID), SignupTTL)
+}
+
+// VerifySignup checks a verification token and returns the pending signup it was issued for
+func (s *Signer) VerifySignup(token string) (int, error) {
+	claims, err := s.Verify(token, SignupKind)
+	if err != nil {
+		return 0, err
+	}
+	signupID, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return 0, ErrInvalidToken
+	}
+	return signupID, nil
+}
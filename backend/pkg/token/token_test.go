@@ -0,0 +1,156 @@
+package token
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// signClaims signs arbitrary claims, including ones Sign would never produce
+func signClaims(t *testing.T, s *Signer, claims Claims) string {
+	t.Helper()
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + s.signature(encoded)
+}
+
+func TestVerify(t *testing.T) {
+	s := NewSigner([]byte("secret"))
+	valid, err := s.Sign(SignupKind, "42", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, signature, _ := strings.Cut(valid, ".")
+	forged, err := NewSigner([]byte("other")).Sign(SignupKind, "42", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The same subject with a different ID, keeping the original signature
+	tampered := base64.RawURLEncoding.EncodeToString([]byte(`{"k":"verify_email","s":"43"}`)) + "." + signature
+
+	tests := []struct {
+		name    string
+		token   string
+		kind    string
+		wantErr error
+	}{
+		{name: "valid", token: valid, kind: SignupKind},
+		{name: "never expires", token: signClaims(t, s, Claims{Kind: SignupKind, Subject: "42"}), kind: SignupKind},
+		{name: "tampered payload", token: tampered, kind: SignupKind, wantErr: ErrInvalidToken},
+		{name: "tampered signature", token: encoded + "." + signature[1:], kind: SignupKind, wantErr: ErrInvalidToken},
+		{name: "signed with another key", token: forged, kind: SignupKind, wantErr: ErrInvalidToken},
+		{name: "missing signature", token: encoded, kind: SignupKind, wantErr: ErrInvalidToken},
+		{name: "empty", kind: SignupKind, wantErr: ErrInvalidToken},
+		{name: "payload is not base64", token: "!!." + s.signature("!!"), kind: SignupKind, wantErr: ErrInvalidToken},
+		{name: "payload is not JSON", token: "bm90anNvbg." + s.signature("bm90anNvbg"), kind: SignupKind, wantErr: ErrInvalidToken},
+		{name: "wrong kind", token: valid, kind: FeedbackKind, wantErr: ErrInvalidToken},
+		{
+			name:    "expired",
+			token:   signClaims(t, s, Claims{Kind: SignupKind, Subject: "42", ExpiresAt: time.Now().Add(-time.Minute).Unix()}),
+			kind:    SignupKind,
+			wantErr: ErrExpiredToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := s.Verify(tt.token, tt.kind)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && claims.Subject != "42" {
+				t.Errorf("got subject %q, want %q", claims.Subject, "42")
+			}
+		})
+	}
+}
+
+func TestSignup(t *testing.T) {
+	s := NewSigner([]byte("secret"))
+	token, err := s.SignSignup(7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signupID, err := s.VerifySignup(token); err != nil || signupID != 7 {
+		t.Errorf("VerifySignup() = %d, %v, want 7", signupID, err)
+	}
+	// A signup token cannot stand in for a match token
+	if _, err := s.VerifyFeedback(token); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("VerifyFeedback() on a signup token = %v, want %v", err, ErrInvalidToken)
+	}
+	bad := signClaims(t, s, Claims{Kind: SignupKind, Subject: "seven"})
+	if _, err := s.VerifySignup(bad); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("VerifySignup() on a non-numeric subject = %v, want %v", err, ErrInvalidToken)
+	}
+}
+
+func TestMatchTokens(t *testing.T) {
+	s := NewSigner([]byte("secret"))
+	tokens := []MatchToken{
+		{MatchID: 3, UserID: 9},
+		{MatchID: 3, UserID: 9, Generation: 1},
+		{MatchID: 3, UserID: 9, Generation: 12},
+	}
+	for _, want := range tokens {
+		feedback, err := s.SignFeedback(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := s.VerifyFeedback(feedback); err != nil || got != want {
+			t.Errorf("VerifyFeedback() = %+v, %v, want %+v", got, err, want)
+		}
+		if _, err := s.VerifyResponse(feedback); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("VerifyResponse() on a feedback token = %v, want %v", err, ErrInvalidToken)
+		}
+
+		response, err := s.SignResponse(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := s.VerifyResponse(response); err != nil || got != want {
+			t.Errorf("VerifyResponse() = %+v, %v, want %+v", got, err, want)
+		}
+		if _, err := s.VerifyFeedback(response); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("VerifyFeedback() on a response token = %v, want %v", err, ErrInvalidToken)
+		}
+	}
+
+	// Links issued before the first revocation carry no generation, so they
+	// read as generation zero and stop matching once the match moves on
+	old := signClaims(t, s, Claims{Kind: FeedbackKind, Subject: "3:9"})
+	if got, err := s.VerifyFeedback(old); err != nil || got.Generation != 0 {
+		t.Errorf("VerifyFeedback() on a first generation token = %+v, %v, want generation 0", got, err)
+	}
+
+	for _, subject := range []string{"3", "3:9:1:2", "3:x", "3:9:x", ""} {
+		token := signClaims(t, s, Claims{Kind: FeedbackKind, Subject: subject})
+		if _, err := s.VerifyFeedback(token); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("VerifyFeedback() with subject %q = %v, want %v", subject, err, ErrInvalidToken)
+		}
+	}
+}
+
+func TestMatchTokenExpiry(t *testing.T) {
+	s := NewSigner([]byte("secret"))
+	expired := signClaims(t, s, Claims{Kind: ResponseKind, Subject: "3:9", ExpiresAt: time.Now().Add(-time.Second).Unix()})
+	if _, err := s.VerifyResponse(expired); !errors.Is(err, ErrExpiredToken) {
+		t.Errorf("VerifyResponse() on an expired token = %v, want %v", err, ErrExpiredToken)
+	}
+
+	token, err := s.SignFeedback(MatchToken{MatchID: 3, UserID: 9})
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims, err := s.Verify(token, FeedbackKind)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ttl := time.Until(time.Unix(claims.ExpiresAt, 0)); ttl < FeedbackTTL-time.Minute || ttl > FeedbackTTL {
+		t.Errorf("feedback token expires in %v, want %v", ttl, FeedbackTTL)
+	}
+}
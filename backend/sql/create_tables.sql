@@ -1,10 +1,42 @@
+drop table if exists pending_signups;
+drop table if exists outbox;
+drop table if exists match_feedback;
+drop table if exists carryovers;
+drop table if exists match_group_members;
+drop table if exists match_groups;
+drop table if exists match_users;
+drop table if exists matches;
 drop table if exists user_artists;
+drop table if exists round_users;
 drop table if exists artists;
 drop table if exists users;
+drop table if exists rounds;
 
 -- Enable the uuid-ossp extension for generating UUIDs (if needed)
 CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
 
+CREATE TABLE rounds (
+    round_id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    capacity INT NOT NULL,  -- Maximum number of users that can join the round
+    status TEXT NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'open', 'closed')),
+    scorer TEXT NOT NULL DEFAULT 'inverse_rank',  -- Similarity strategy used when matching
+    genre_weight DOUBLE PRECISION NOT NULL DEFAULT 0.25,  -- Share of the similarity from genre overlap
+    mode TEXT NOT NULL DEFAULT 'pairs' CHECK (mode IN ('pairs', 'groups', 'stable', 'discovery', 'bipartite')),
+    objective TEXT NOT NULL DEFAULT 'total' CHECK (objective IN ('total', 'bottleneck')),  -- What pairs mode optimizes
+    group_size INT NOT NULL DEFAULT 3,  -- Minimum number of users per group in group mode
+    min_score INT NOT NULL DEFAULT 0,  -- Lowest match score allowed; users below it are carried over
+    candidates INT NOT NULL DEFAULT 0,  -- Likely matches scored per user in approximate mode, 0 scores every pair
+    sides TEXT[] NOT NULL DEFAULT '{}',  -- The two sides users choose from at signup, matched across in bipartite mode
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    opened_at TIMESTAMP,
+    closed_at TIMESTAMP,
+    matched_at TIMESTAMP  -- Set when match results are stored for the round
+);
+
+-- Only one round can accept signups at a time
+CREATE UNIQUE INDEX idx_rounds_single_open ON rounds(status) WHERE status = 'open';
+
 CREATE TABLE users (
     user_id SERIAL PRIMARY KEY,
     first_name TEXT NOT NULL,
@@ -12,6 +44,9 @@ CREATE TABLE users (
     email TEXT UNIQUE NOT NULL,
     phone_number TEXT,
     spotify_user_id TEXT UNIQUE,  -- Unique identifier from Spotify
+    notification_channels TEXT[] NOT NULL DEFAULT '{email}'
+        CHECK (notification_channels <@ ARRAY['email', 'sms']),  -- Channels the user wants to be notified on
+    email_verified_at TIMESTAMP,  -- Set once the user confirmed their email address
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
 
@@ -25,12 +60,137 @@ CREATE TABLE artists (
     spotify_url TEXT
 );
 
+CREATE TABLE round_users (
+    round_id INT REFERENCES rounds(round_id),
+    user_id INT REFERENCES users(user_id),
+    joined_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    carried_over_from INT REFERENCES rounds(round_id),  -- Set for users left unmatched in an earlier round
+    discovery BOOLEAN NOT NULL DEFAULT FALSE,  -- Opted in to a partner who can broaden their listening
+    side TEXT NOT NULL DEFAULT '',  -- Side chosen at signup in rounds with sides
+    PRIMARY KEY (round_id, user_id)
+);
+
 CREATE TABLE user_artists (
+    round_id INT REFERENCES rounds(round_id),
     user_id INT REFERENCES users(user_id),
     artist_id INT REFERENCES artists(artist_id),
     rank INT,  -- Optional: rank of the artist for the user (e.g., 1 for top artist)
-    PRIMARY KEY (user_id, artist_id)
+    PRIMARY KEY (round_id, user_id, artist_id),
+    FOREIGN KEY (round_id, user_id) REFERENCES round_users(round_id, user_id)
+);
+
+CREATE TABLE matches (
+    match_id SERIAL PRIMARY KEY,
+    round_id INT NOT NULL REFERENCES rounds(round_id),
+    user_a_id INT NOT NULL REFERENCES users(user_id),
+    user_b_id INT NOT NULL REFERENCES users(user_id),
+    similarity DOUBLE PRECISION NOT NULL,
+    match_score INT NOT NULL,  -- User-friendly 0-100 score
+    shared_artist_ids INT[] NOT NULL DEFAULT '{}',  -- Internal artist IDs, best shared artists first
+    artist_contributions DOUBLE PRECISION[] NOT NULL DEFAULT '{}',  -- Similarity added by each shared artist, same order
+    genre_contribution DOUBLE PRECISION NOT NULL DEFAULT 0,  -- Similarity added by genre overlap
+    shared_genres TEXT[] NOT NULL DEFAULT '{}',  -- Strongest shared genres first
+    -- Whether each user agreed to share contact details with the other
+    user_a_response TEXT NOT NULL DEFAULT 'pending' CHECK (user_a_response IN ('pending', 'accepted', 'declined')),
+    user_b_response TEXT NOT NULL DEFAULT 'pending' CHECK (user_b_response IN ('pending', 'accepted', 'declined')),
+    revealed_at TIMESTAMP,  -- Set once both users accepted, after which contact details are shared
+    -- Generation of each user's match links; only links of the current generation are accepted
+    user_a_link_generation INT NOT NULL DEFAULT 0,
+    user_b_link_generation INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (user_a_id < user_b_id)
+);
+
+-- Both users of each match, so that a user is in at most one match per round
+-- whichever side of the pair they are stored on
+CREATE TABLE match_users (
+    round_id INT NOT NULL REFERENCES rounds(round_id),
+    user_id INT NOT NULL REFERENCES users(user_id),
+    match_id INT NOT NULL REFERENCES matches(match_id) ON DELETE CASCADE,
+    PRIMARY KEY (round_id, user_id)
+);
+
+CREATE TABLE match_feedback (
+    match_id INT NOT NULL REFERENCES matches(match_id) ON DELETE RESTRICT,
+    user_id INT NOT NULL REFERENCES users(user_id),
+    connected BOOLEAN NOT NULL,  -- Whether the user got in touch with their match
+    rating INT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    comment TEXT NOT NULL DEFAULT '',
+    submitted_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (match_id, user_id)
+);
+
+CREATE TABLE match_groups (
+    group_id SERIAL PRIMARY KEY,
+    round_id INT NOT NULL REFERENCES rounds(round_id),
+    similarity DOUBLE PRECISION NOT NULL,  -- Mean similarity over all pairs in the group
+    match_score INT NOT NULL,  -- User-friendly 0-100 score
+    shared_artist_ids INT[] NOT NULL DEFAULT '{}',  -- Artists every member listed, best shared artists first
+    shared_genres TEXT[] NOT NULL DEFAULT '{}',  -- Genres every member listens to, strongest first
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE match_group_members (
+    group_id INT NOT NULL REFERENCES match_groups(group_id) ON DELETE CASCADE,
+    round_id INT NOT NULL REFERENCES rounds(round_id),
+    user_id INT NOT NULL REFERENCES users(user_id),
+    PRIMARY KEY (group_id, user_id),
+    UNIQUE (round_id, user_id)  -- A user belongs to at most one group per round
+);
+
+-- Users left unmatched in a round, enrolled with priority into the next round when it opens
+CREATE TABLE carryovers (
+    from_round_id INT NOT NULL REFERENCES rounds(round_id),
+    user_id INT NOT NULL REFERENCES users(user_id),
+    to_round_id INT REFERENCES rounds(round_id),  -- NULL until the next round opens
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (from_round_id, user_id)
+);
+
+-- Notifications to deliver, each enqueued once per user, match, channel and template
+CREATE TABLE outbox (
+    notification_id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(user_id),
+    match_id INT NOT NULL REFERENCES matches(match_id) ON DELETE RESTRICT,
+    channel TEXT NOT NULL,  -- How the notification is delivered, e.g. 'email'
+    template TEXT NOT NULL,  -- Which notification this is, e.g. 'match'
+    sender TEXT NOT NULL,
+    recipient TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    body TEXT NOT NULL,  -- Rendered when enqueued, so retries send the same content
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sending', 'sent', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT NOT NULL DEFAULT '',
+    provider_message_id TEXT NOT NULL DEFAULT '',  -- ID the mailer assigned to the delivered message
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    claimed_at TIMESTAMP,  -- When a worker last started delivering it
+    sent_at TIMESTAMP,
+    UNIQUE (user_id, match_id, channel, template)
+);
+
+-- Manual signups waiting for the user to confirm their email address. They don't
+-- count toward the round until confirmed, when the user is created and enrolled.
+CREATE TABLE pending_signups (
+    signup_id SERIAL PRIMARY KEY,
+    round_id INT NOT NULL REFERENCES rounds(round_id) ON DELETE CASCADE,
+    email TEXT NOT NULL,
+    first_name TEXT NOT NULL,
+    last_name TEXT NOT NULL,
+    phone_number TEXT,
+    notification_channels TEXT[] NOT NULL DEFAULT '{email}',
+    discovery BOOLEAN NOT NULL DEFAULT FALSE,
+    side TEXT NOT NULL DEFAULT '',
+    artist_ids TEXT[] NOT NULL,  -- Spotify artist IDs in the order the user selected them
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_sent_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,  -- When the verification email was last sent
+    expires_at TIMESTAMP NOT NULL,
+    UNIQUE (email, round_id)
 );
 
+CREATE UNIQUE INDEX idx_matches_round_user_a ON matches(round_id, user_a_id);
+CREATE UNIQUE INDEX idx_matches_round_user_b ON matches(round_id, user_b_id);
+CREATE INDEX idx_round_users_user_id ON round_users(user_id);
 CREATE INDEX idx_user_artists_user_id ON user_artists(user_id);
 CREATE INDEX idx_user_artists_artist_id ON user_artists(artist_id);
+CREATE INDEX idx_outbox_due ON outbox(next_attempt_at) WHERE status = 'pending';
@@ -1,9 +1,13 @@
 -- Clear existing data
-TRUNCATE users, artists, user_artists CASCADE;
+TRUNCATE rounds, users, artists, round_users, user_artists CASCADE;
 
 -- Enable the uuid-ossp extension (should already be enabled from create_tables.sql)
 CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
 
+-- Create an open round for the seeded users
+INSERT INTO rounds (name, capacity, status, opened_at) VALUES
+('Seed round', 500, 'open', CURRENT_TIMESTAMP);
+
 -- Insert users
 INSERT INTO users (user_id, first_name, last_name, email, phone_number) VALUES
 (uuid_generate_v4(), 'John', 'Doe', 'john.doe@example.com', '555-123-4567'),
@@ -18,6 +22,8 @@ INSERT INTO users (user_id, first_name, last_name, email, phone_number) VALUES
 -- Store user IDs in variables for later use in user_artists
 DO $$
 DECLARE
+    seed_round_id INT;
+
     user1_id UUID;
     user2_id UUID;
     user3_id UUID;
@@ -43,6 +49,8 @@ DECLARE
     artist14_id INT;
     artist15_id INT;
 BEGIN
+    SELECT round_id INTO seed_round_id FROM rounds WHERE name = 'Seed round';
+
     -- Get the user IDs that were just inserted
     SELECT user_id INTO user1_id FROM users WHERE email = 'john.doe@example.com';
     SELECT user_id INTO user2_id FROM users WHERE email = 'jane.smith@example.com';
@@ -85,75 +93,79 @@ BEGIN
     INSERT INTO artists (spotify_artist_id, artist_name) VALUES
     ('6M2wZ9GZgrQXHCFfjv46we', 'Dua Lipa') RETURNING artist_id INTO artist15_id;
     
+    -- Enroll every user in the seed round
+    INSERT INTO round_users (round_id, user_id)
+    SELECT seed_round_id, user_id FROM users;
+
     -- Insert user-artist relationships with ranks
     -- User 1 likes rock and alternative
-    INSERT INTO user_artists (user_id, artist_id, rank) VALUES
-    (user1_id, artist1_id, 1),  -- Radiohead (top)
-    (user1_id, artist2_id, 2),  -- The Beatles
-    (user1_id, artist3_id, 3),  -- David Bowie
-    (user1_id, artist4_id, 4),  -- Led Zeppelin
-    (user1_id, artist5_id, 5),  -- Red Hot Chili Peppers
-    (user1_id, artist6_id, 6);  -- Nirvana
+    INSERT INTO user_artists (round_id, user_id, artist_id, rank) VALUES
+    (seed_round_id, user1_id, artist1_id, 1),  -- Radiohead (top)
+    (seed_round_id, user1_id, artist2_id, 2),  -- The Beatles
+    (seed_round_id, user1_id, artist3_id, 3),  -- David Bowie
+    (seed_round_id, user1_id, artist4_id, 4),  -- Led Zeppelin
+    (seed_round_id, user1_id, artist5_id, 5),  -- Red Hot Chili Peppers
+    (seed_round_id, user1_id, artist6_id, 6);  -- Nirvana
     
     -- User 2 likes pop and some rock
-    INSERT INTO user_artists (user_id, artist_id, rank) VALUES
-    (user2_id, artist7_id, 1),  -- Taylor Swift (top)
-    (user2_id, artist9_id, 2),  -- Ed Sheeran
-    (user2_id, artist12_id, 3), -- The Weeknd
-    (user2_id, artist14_id, 4), -- Coldplay
-    (user2_id, artist2_id, 5);  -- The Beatles
+    INSERT INTO user_artists (round_id, user_id, artist_id, rank) VALUES
+    (seed_round_id, user2_id, artist7_id, 1),  -- Taylor Swift (top)
+    (seed_round_id, user2_id, artist9_id, 2),  -- Ed Sheeran
+    (seed_round_id, user2_id, artist12_id, 3), -- The Weeknd
+    (seed_round_id, user2_id, artist14_id, 4), -- Coldplay
+    (seed_round_id, user2_id, artist2_id, 5);  -- The Beatles
     
     -- User 3 likes a mix of genres
-    INSERT INTO user_artists (user_id, artist_id, rank) VALUES
-    (user3_id, artist5_id, 1),  -- Red Hot Chili Peppers (top)
-    (user3_id, artist8_id, 2),  -- Drake
-    (user3_id, artist14_id, 3), -- Coldplay
-    (user3_id, artist4_id, 4),  -- Led Zeppelin
-    (user3_id, artist15_id, 5), -- Dua Lipa
-    (user3_id, artist9_id, 6),  -- Ed Sheeran
-    (user3_id, artist3_id, 7);  -- David Bowie
+    INSERT INTO user_artists (round_id, user_id, artist_id, rank) VALUES
+    (seed_round_id, user3_id, artist5_id, 1),  -- Red Hot Chili Peppers (top)
+    (seed_round_id, user3_id, artist8_id, 2),  -- Drake
+    (seed_round_id, user3_id, artist14_id, 3), -- Coldplay
+    (seed_round_id, user3_id, artist4_id, 4),  -- Led Zeppelin
+    (seed_round_id, user3_id, artist15_id, 5), -- Dua Lipa
+    (seed_round_id, user3_id, artist9_id, 6),  -- Ed Sheeran
+    (seed_round_id, user3_id, artist3_id, 7);  -- David Bowie
     
     -- User 4 likes pop
-    INSERT INTO user_artists (user_id, artist_id, rank) VALUES
-    (user4_id, artist7_id, 1),  -- Taylor Swift (top)
-    (user4_id, artist15_id, 2), -- Dua Lipa
-    (user4_id, artist12_id, 3), -- The Weeknd
-    (user4_id, artist9_id, 4),  -- Ed Sheeran
-    (user4_id, artist10_id, 5), -- Justin Bieber
-    (user4_id, artist13_id, 6); -- BTS
+    INSERT INTO user_artists (round_id, user_id, artist_id, rank) VALUES
+    (seed_round_id, user4_id, artist7_id, 1),  -- Taylor Swift (top)
+    (seed_round_id, user4_id, artist15_id, 2), -- Dua Lipa
+    (seed_round_id, user4_id, artist12_id, 3), -- The Weeknd
+    (seed_round_id, user4_id, artist9_id, 4),  -- Ed Sheeran
+    (seed_round_id, user4_id, artist10_id, 5), -- Justin Bieber
+    (seed_round_id, user4_id, artist13_id, 6); -- BTS
     
     -- User 5 likes rock
-    INSERT INTO user_artists (user_id, artist_id, rank) VALUES
-    (user5_id, artist4_id, 1),  -- Led Zeppelin (top)
-    (user5_id, artist1_id, 2),  -- Radiohead
-    (user5_id, artist6_id, 3),  -- Nirvana
-    (user5_id, artist2_id, 4),  -- The Beatles
-    (user5_id, artist3_id, 5),  -- David Bowie
-    (user5_id, artist5_id, 6);  -- Red Hot Chili Peppers
+    INSERT INTO user_artists (round_id, user_id, artist_id, rank) VALUES
+    (seed_round_id, user5_id, artist4_id, 1),  -- Led Zeppelin (top)
+    (seed_round_id, user5_id, artist1_id, 2),  -- Radiohead
+    (seed_round_id, user5_id, artist6_id, 3),  -- Nirvana
+    (seed_round_id, user5_id, artist2_id, 4),  -- The Beatles
+    (seed_round_id, user5_id, artist3_id, 5),  -- David Bowie
+    (seed_round_id, user5_id, artist5_id, 6);  -- Red Hot Chili Peppers
     
     -- User 6 likes pop and hip-hop
-    INSERT INTO user_artists (user_id, artist_id, rank) VALUES
-    (user6_id, artist8_id, 1),  -- Drake (top)
-    (user6_id, artist12_id, 2), -- The Weeknd
-    (user6_id, artist7_id, 3),  -- Taylor Swift
-    (user6_id, artist10_id, 4), -- Justin Bieber
-    (user6_id, artist15_id, 5); -- Dua Lipa
+    INSERT INTO user_artists (round_id, user_id, artist_id, rank) VALUES
+    (seed_round_id, user6_id, artist8_id, 1),  -- Drake (top)
+    (seed_round_id, user6_id, artist12_id, 2), -- The Weeknd
+    (seed_round_id, user6_id, artist7_id, 3),  -- Taylor Swift
+    (seed_round_id, user6_id, artist10_id, 4), -- Justin Bieber
+    (seed_round_id, user6_id, artist15_id, 5); -- Dua Lipa
     
     -- User 7 likes alternative and some pop
-    INSERT INTO user_artists (user_id, artist_id, rank) VALUES
-    (user7_id, artist1_id, 1),  -- Radiohead (top)
-    (user7_id, artist14_id, 2), -- Coldplay
-    (user7_id, artist6_id, 3),  -- Nirvana
-    (user7_id, artist3_id, 4),  -- David Bowie
-    (user7_id, artist9_id, 5),  -- Ed Sheeran
-    (user7_id, artist15_id, 6); -- Dua Lipa
+    INSERT INTO user_artists (round_id, user_id, artist_id, rank) VALUES
+    (seed_round_id, user7_id, artist1_id, 1),  -- Radiohead (top)
+    (seed_round_id, user7_id, artist14_id, 2), -- Coldplay
+    (seed_round_id, user7_id, artist6_id, 3),  -- Nirvana
+    (seed_round_id, user7_id, artist3_id, 4),  -- David Bowie
+    (seed_round_id, user7_id, artist9_id, 5),  -- Ed Sheeran
+    (seed_round_id, user7_id, artist15_id, 6); -- Dua Lipa
     
     -- User 8 likes a mix of genres
-    INSERT INTO user_artists (user_id, artist_id, rank) VALUES
-    (user8_id, artist15_id, 1), -- Dua Lipa (top)
-    (user8_id, artist14_id, 2), -- Coldplay
-    (user8_id, artist2_id, 3),  -- The Beatles
-    (user8_id, artist9_id, 4),  -- Ed Sheeran
-    (user8_id, artist13_id, 5), -- BTS
-    (user8_id, artist7_id, 6);  -- Taylor Swift
+    INSERT INTO user_artists (round_id, user_id, artist_id, rank) VALUES
+    (seed_round_id, user8_id, artist15_id, 1), -- Dua Lipa (top)
+    (seed_round_id, user8_id, artist14_id, 2), -- Coldplay
+    (seed_round_id, user8_id, artist2_id, 3),  -- The Beatles
+    (seed_round_id, user8_id, artist9_id, 4),  -- Ed Sheeran
+    (seed_round_id, user8_id, artist13_id, 5), -- BTS
+    (seed_round_id, user8_id, artist7_id, 6);  -- Taylor Swift
 END $$;
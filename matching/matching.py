@@ -50,19 +50,33 @@ cur = conn.cursor()
 cur.execute("SELECT MAX(artist_id) FROM artists;")
 max_artist_id = cur.fetchone()[0]
 
-# Fetch user details
+# Match the most recently opened round (the open one, or the last closed one)
 cur.execute("""
-    SELECT user_id, first_name, last_name, email, phone_number
-    FROM users
+    SELECT round_id FROM rounds
+    WHERE status IN ('open', 'closed')
+    ORDER BY opened_at DESC NULLS LAST
+    LIMIT 1
 """)
+round_row = cur.fetchone()
+if not round_row:
+    raise ValueError("No round has been opened yet")
+round_id = round_row[0]
+
+# Fetch user details
+cur.execute("""
+    SELECT u.user_id, u.first_name, u.last_name, u.email, u.phone_number
+    FROM users u
+    JOIN round_users ru ON u.user_id = ru.user_id
+    WHERE ru.round_id = %s
+""", (round_id,))
 user_details = {user_id: (first_name, last_name, email, phone_number) for user_id, first_name, last_name, email, phone_number in cur.fetchall()}
 
 # Fetch user-artist data
 cur.execute("""
-    SELECT u.user_id, ua.artist_id, ua.rank
-    FROM users u
-    JOIN user_artists ua ON u.user_id = ua.user_id
-""")
+    SELECT ua.user_id, ua.artist_id, ua.rank
+    FROM user_artists ua
+    WHERE ua.round_id = %s
+""", (round_id,))
 rows = cur.fetchall()
 
 # Process data into user vectors
@@ -153,7 +153,18 @@ def seed_database_from_csv():
         
         # Clear existing data
         print("Clearing existing data...")
-        cur.execute("TRUNCATE users, artists, user_artists CASCADE;")
+        cur.execute("TRUNCATE rounds, users, artists, round_users, user_artists CASCADE;")
+
+        # Create an open round for the seeded users
+        cur.execute(
+            """
+            INSERT INTO rounds (name, capacity, status, opened_at)
+            VALUES (%s, %s, 'open', CURRENT_TIMESTAMP)
+            RETURNING round_id
+            """,
+            ("Seed round", NUM_USERS)
+        )
+        round_id = cur.fetchone()[0]
         
         # Insert users from CSV
         print("Inserting users from CSV...")
@@ -201,14 +212,24 @@ def seed_database_from_csv():
                     int(ua["rank"])
                 ))
         
+        print("Enrolling users in the seed round...")
+        execute_values(
+            cur,
+            """
+            INSERT INTO round_users (round_id, user_id)
+            VALUES %s
+            """,
+            [(round_id, user_id) for user_id in user_ids]
+        )
+
         print(f"Inserting {len(user_artists_data)} user-artist relationships...")
         execute_values(
             cur,
             """
-            INSERT INTO user_artists (user_id, artist_id, rank)
+            INSERT INTO user_artists (round_id, user_id, artist_id, rank)
             VALUES %s
             """,
-            user_artists_data
+            [(round_id, user_id, artist_id, rank) for user_id, artist_id, rank in user_artists_data]
         )
         
         # Commit the transaction
@@ -243,7 +264,18 @@ def seed_database():
         
         # Clear existing data
         print("Clearing existing data...")
-        cur.execute("TRUNCATE users, artists, user_artists CASCADE;")
+        cur.execute("TRUNCATE rounds, users, artists, round_users, user_artists CASCADE;")
+
+        # Create an open round for the seeded users
+        cur.execute(
+            """
+            INSERT INTO rounds (name, capacity, status, opened_at)
+            VALUES (%s, %s, 'open', CURRENT_TIMESTAMP)
+            RETURNING round_id
+            """,
+            ("Seed round", NUM_USERS)
+        )
+        round_id = cur.fetchone()[0]
         
         # Insert users
         print(f"Inserting {NUM_USERS} users...")
@@ -285,14 +317,24 @@ def seed_database():
             writer.writeheader()
             writer.writerows(user_artists)
         
+        print("Enrolling users in the seed round...")
+        execute_values(
+            cur,
+            """
+            INSERT INTO round_users (round_id, user_id)
+            VALUES %s
+            """,
+            [(round_id, user_id) for user_id in user_ids]
+        )
+
         print(f"Inserting {len(user_artists)} user-artist relationships...")
         execute_values(
             cur,
             """
-            INSERT INTO user_artists (user_id, artist_id, rank)
+            INSERT INTO user_artists (round_id, user_id, artist_id, rank)
             VALUES %s
             """,
-            [(ua["user_id"], ua["artist_id"], ua["rank"]) for ua in user_artists]
+            [(round_id, ua["user_id"], ua["artist_id"], ua["rank"]) for ua in user_artists]
         )
         
         # Commit the transaction
@@ -4,6 +4,7 @@ import os
 import argparse
 import requests
 from typing import List, Dict, Any, Tuple
+from urllib.parse import urlencode
 
 def read_matches_csv(csv_path: str) -> List[Dict[str, Any]]:
     """
@@ -16,7 +17,16 @@ def read_matches_csv(csv_path: str) -> List[Dict[str, Any]]:
             matches.append(row)
     return matches
 
-def prepare_email_pairs(matches: List[Dict[str, Any]]) -> List[Tuple[Dict[str, Any], Dict[str, Any]]]:
+def feedback_link(feedback_url: str, token: str) -> str:
+    """
+    Build a user's link to the feedback page from their feedback token.
+    Returns an empty string if there is no feedback page or token.
+    """
+    if not feedback_url or not token:
+        return ''
+    return f"{feedback_url}?{urlencode({'token': token})}"
+
+def prepare_email_pairs(matches: List[Dict[str, Any]], feedback_url: str = None) -> List[Tuple[Dict[str, Any], Dict[str, Any]]]:
     """
     Prepare email data for each user in the match pairs.
     Returns a list of tuples, each containing email data for both users in a match.
@@ -35,7 +45,8 @@ def prepare_email_pairs(matches: List[Dict[str, Any]]) -> List[Tuple[Dict[str, A
             'match_phone': match['user2_phone'],
             'similarity_score': float(match['similarity_score']),
             'match_score': int(match['match_score']),
-            'common_artists': match['common_artists'].split('|')
+            'common_artists': match['common_artists'].split('|'),
+            'feedback_url': feedback_link(feedback_url, match.get('user1_feedback_token'))
         }
         
         # Extract data for second user
@@ -49,7 +60,8 @@ def prepare_email_pairs(matches: List[Dict[str, Any]]) -> List[Tuple[Dict[str, A
             'match_phone': match['user1_phone'],
             'similarity_score': float(match['similarity_score']),
             'match_score': int(match['match_score']),
-            'common_artists': match['common_artists'].split('|')
+            'common_artists': match['common_artists'].split('|'),
+            'feedback_url': feedback_link(feedback_url, match.get('user2_feedback_token'))
         }
         
         email_pairs.append((user1_data, user2_data))
@@ -73,6 +85,10 @@ def format_email_content(user_data: Dict[str, Any], template_path: str = None) -
     if not template_data.get('match_phone') or not template_data['match_phone'].strip():
         template_data['match_phone'] = "Not provided"
     
+    # Fall back to asking for a reply when there is no feedback link
+    if not template_data.get('feedback_url'):
+        template_data['feedback_url'] = "just reply to this email"
+    
     if template_path and os.path.exists(template_path):
         with open(template_path, 'r') as f:
             template = f.read()
@@ -131,6 +147,7 @@ def main():
     parser.add_argument('--subject', default='Your Musical Match!', help='Email subject')
     parser.add_argument('--test', action='store_true', help='Test mode - do not send actual emails')
     parser.add_argument('--limit', type=int, help='Limit the number of emails to send')
+    parser.add_argument('--feedback-url', help='URL of the match feedback page, e.g. https://example.com/feedback')
     
     args = parser.parse_args()
     
@@ -139,7 +156,7 @@ def main():
     print(f"Read {len(matches)} matches from {args.csv_file}")
     
     # Prepare email data
-    email_pairs = prepare_email_pairs(matches)
+    email_pairs = prepare_email_pairs(matches, args.feedback_url)
     
     # Apply limit if specified
     if args.limit and args.limit > 0:
@@ -27,6 +27,7 @@ function App() {
   // State for user count and limit status
   const [userCount, setUserCount] = useState(0);
   const [maxUsers, setMaxUsers] = useState(0);
+  const [sides, setSides] = useState([]);
   const [isAnimating, setIsAnimating] = useState(false);
   const [isLimitReached, setIsLimitReached] = useState(false);
   
@@ -44,7 +45,10 @@ function App() {
     firstName: '',
     lastName: '',
     email: '',
-    phoneNumber: ''
+    phoneNumber: '',
+    discovery: false,
+    textMe: false,
+    side: ''
   });
   
   // Function to fetch user count from API
@@ -62,6 +66,9 @@ function App() {
         // Check if we've reached the limit based on the max_users from the API
         setIsLimitReached(data.count >= data.maxUsers);
       }
+
+      // Rounds with sides ask users which one they belong to
+      setSides(data.sides);
     } catch (error) {
       console.error('Error fetching user count:', error);
     }
@@ -120,8 +127,9 @@ function App() {
                   formData={formData}
                   errors={errors}
                   handleChange={handleChange}
-                  validateForm={validateForm}
+                  validateForm={() => validateForm(sides)}
                   isLimitReached={isLimitReached}
+                  sides={sides}
                   selectedArtists={selectedArtists}
                   setSelectedArtists={setSelectedArtists}
                   fetchUserCount={getUserCount}
@@ -134,8 +142,9 @@ function App() {
                   formData={formData}
                   errors={errors}
                   handleChange={handleChange}
-                  validateForm={validateForm}
+                  validateForm={() => validateForm(sides)}
                   isLimitReached={isLimitReached}
+                  sides={sides}
                 />
               </TabPanel>
             </TabPanels>
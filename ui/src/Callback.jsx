@@ -20,6 +20,7 @@ import {
 } from '@chakra-ui/react';
 import { ExternalLinkIcon } from '@chakra-ui/icons';
 import SpotifyLogo from './assets/Spotify_Logo_RGB_Black.png';
+import { notificationChannels } from './utils/api';
 
 function Callback() {
   const [status, setStatus] = useState('loading');
@@ -83,6 +84,9 @@ function Callback() {
             lastName: userData.lastName,
             email: userData.email,
             number: userData.phoneNumber,
+            discovery: userData.discovery,
+            side: userData.side,
+            notificationChannels: notificationChannels(userData),
           }),
         });
 
@@ -0,0 +1,168 @@
+import { useState } from 'react';
+import { useNavigate, useLocation } from 'react-router-dom';
+import {
+  Box,
+  Heading,
+  Text,
+  Alert,
+  AlertIcon,
+  AlertTitle,
+  AlertDescription,
+  VStack,
+  HStack,
+  Button,
+  FormControl,
+  FormLabel,
+  Radio,
+  RadioGroup,
+  Textarea,
+} from '@chakra-ui/react';
+import { submitMatchFeedback } from './utils/api';
+
+const MAX_COMMENT_LENGTH = 2000;
+
+function Feedback() {
+  const [status, setStatus] = useState('idle');
+  const [error, setError] = useState(null);
+  const [connected, setConnected] = useState('');
+  const [rating, setRating] = useState('');
+  const [comment, setComment] = useState('');
+  const navigate = useNavigate();
+  const location = useLocation();
+
+  const feedbackToken = new URLSearchParams(location.search).get('token');
+
+  const handleSubmit = async (e) => {
+    e.preventDefault();
+    setStatus('submitting');
+    setError(null);
+    try {
+      await submitMatchFeedback({
+        feedbackToken,
+        connected: connected === 'yes',
+        rating: Number(rating),
+        comment: comment.trim(),
+      });
+      setStatus('success');
+    } catch (err) {
+      setError(err.message);
+      setStatus('idle');
+    }
+  };
+
+  const handleGoHome = () => {
+    navigate('/');
+  };
+
+  if (!feedbackToken || status === 'success') {
+    return (
+      <Box bg="#faf0e6" height="100vh" display="flex" justifyContent="center" alignItems="center" p={4}>
+        <Alert
+          status={feedbackToken ? 'success' : 'error'}
+          variant="subtle"
+          flexDirection="column"
+          alignItems="center"
+          justifyContent="center"
+          textAlign="center"
+          height="auto"
+          borderRadius="lg"
+          p={6}
+        >
+          <AlertIcon boxSize="40px" mr={0} />
+          <AlertTitle mt={4} mb={1} fontSize="lg">
+            {feedbackToken ? 'Thanks for your feedback!' : 'Missing feedback link'}
+          </AlertTitle>
+          <AlertDescription maxWidth="sm">
+            {feedbackToken
+              ? 'Your feedback helps us make better matches.'
+              : 'Please use the feedback link from your match email.'}
+          </AlertDescription>
+          <Button mt={4} colorScheme="green" onClick={handleGoHome}>
+            Go Back Home
+          </Button>
+        </Alert>
+      </Box>
+    );
+  }
+
+  return (
+    <Box bg="#faf0e6" minHeight="100vh" py={8}>
+      <Box
+        minWidth="350px"
+        maxWidth="600px"
+        mx="auto"
+        p={8}
+        bg="#fafcff"
+        borderRadius="lg"
+        boxShadow="md"
+        w="60%"
+      >
+        <form onSubmit={handleSubmit}>
+          <VStack spacing={6} align="stretch">
+            <Box textAlign="center">
+              <Heading as="h1" size="xl" mb={2}>
+                How was your match?
+              </Heading>
+              <Text fontSize="lg">
+                Tell us how it went so we can make better matches.
+              </Text>
+            </Box>
+
+            <FormControl isRequired>
+              <FormLabel>Did you connect with your match?</FormLabel>
+              <RadioGroup value={connected} onChange={setConnected} colorScheme="green">
+                <HStack spacing={6}>
+                  <Radio value="yes">Yes</Radio>
+                  <Radio value="no">No</Radio>
+                </HStack>
+              </RadioGroup>
+            </FormControl>
+
+            <FormControl isRequired>
+              <FormLabel>How would you rate your match?</FormLabel>
+              <RadioGroup value={rating} onChange={setRating} colorScheme="green">
+                <HStack spacing={6}>
+                  {[1, 2, 3, 4, 5].map((value) => (
+                    <Radio key={value} value={String(value)}>
+                      {value}
+                    </Radio>
+                  ))}
+                </HStack>
+              </RadioGroup>
+            </FormControl>
+
+            <FormControl>
+              <FormLabel>Anything else you'd like to share?</FormLabel>
+              <Textarea
+                value={comment}
+                onChange={(e) => setComment(e.target.value)}
+                maxLength={MAX_COMMENT_LENGTH}
+                placeholder="Optional"
+                bg="white"
+              />
+            </FormControl>
+
+            {error && (
+              <Alert status="error" borderRadius="md">
+                <AlertIcon />
+                <AlertDescription>{error}</AlertDescription>
+              </Alert>
+            )}
+
+            <Button
+              type="submit"
+              colorScheme="spotifygreen"
+              size="lg"
+              isLoading={status === 'submitting'}
+              isDisabled={!connected || !rating}
+            >
+              Submit Feedback
+            </Button>
+          </VStack>
+        </form>
+      </Box>
+    </Box>
+  );
+}
+
+export default Feedback;
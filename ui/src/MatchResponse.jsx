@@ -0,0 +1,212 @@
+import { useEffect, useState } from 'react';
+import { useNavigate, useLocation } from 'react-router-dom';
+import {
+  Box,
+  Heading,
+  Text,
+  Alert,
+  AlertIcon,
+  AlertTitle,
+  AlertDescription,
+  VStack,
+  HStack,
+  Button,
+  Spinner,
+  Tag,
+  Wrap,
+  WrapItem,
+} from '@chakra-ui/react';
+import { getMatchContact, respondToMatch } from './utils/api';
+
+function MatchResponse() {
+  const [contact, setContact] = useState(null);
+  const [status, setStatus] = useState('loading');
+  const [error, setError] = useState(null);
+  const navigate = useNavigate();
+  const location = useLocation();
+
+  const params = new URLSearchParams(location.search);
+  const responseToken = params.get('token');
+  // The answer from the email link is only preselected; responding still takes
+  // a click, so link scanners opening the email can't accept on the user's behalf
+  const suggestedAnswer = params.get('answer');
+
+  useEffect(() => {
+    if (!responseToken) {
+      return;
+    }
+    getMatchContact(responseToken)
+      .then((result) => {
+        setContact(result);
+        setStatus('idle');
+      })
+      .catch((err) => {
+        setError(err.message);
+        setStatus('failed');
+      });
+  }, [responseToken]);
+
+  const handleRespond = async (accept) => {
+    setStatus(accept ? 'accepting' : 'declining');
+    setError(null);
+    try {
+      setContact(await respondToMatch(responseToken, accept));
+    } catch (err) {
+      setError(err.message);
+    }
+    setStatus('idle');
+  };
+
+  const handleGoHome = () => {
+    navigate('/');
+  };
+
+  if (!responseToken || status === 'failed') {
+    return (
+      <Box bg="#faf0e6" height="100vh" display="flex" justifyContent="center" alignItems="center" p={4}>
+        <Alert
+          status="error"
+          variant="subtle"
+          flexDirection="column"
+          alignItems="center"
+          justifyContent="center"
+          textAlign="center"
+          height="auto"
+          borderRadius="lg"
+          p={6}
+        >
+          <AlertIcon boxSize="40px" mr={0} />
+          <AlertTitle mt={4} mb={1} fontSize="lg">
+            {responseToken ? 'Could not load your match' : 'Missing match link'}
+          </AlertTitle>
+          <AlertDescription maxWidth="sm">
+            {responseToken ? error : 'Please use the link from your match email.'}
+          </AlertDescription>
+          <Button mt={4} colorScheme="green" onClick={handleGoHome}>
+            Go Back Home
+          </Button>
+        </Alert>
+      </Box>
+    );
+  }
+
+  if (status === 'loading') {
+    return (
+      <Box bg="#faf0e6" height="100vh" display="flex" justifyContent="center" alignItems="center">
+        <Spinner size="xl" />
+      </Box>
+    );
+  }
+
+  const partnerName = `${contact.partnerFirstName || ''} ${contact.partnerLastName || ''}`.trim();
+  const contactStatus = contact.status || 'CONTACT_STATUS_PENDING';
+
+  return (
+    <Box bg="#faf0e6" minHeight="100vh" py={8}>
+      <Box
+        minWidth="350px"
+        maxWidth="600px"
+        mx="auto"
+        p={8}
+        bg="#fafcff"
+        borderRadius="lg"
+        boxShadow="md"
+        w="60%"
+      >
+        <VStack spacing={6} align="stretch">
+          <Box textAlign="center">
+            <Heading as="h1" size="xl" mb={2}>
+              Meet {partnerName}
+            </Heading>
+            <Text fontSize="lg">Match score: {contact.matchScore || 0}/100</Text>
+          </Box>
+
+          {contact.commonArtists?.length > 0 && (
+            <Box>
+              <Text fontWeight="bold" mb={2}>
+                Artists you both listen to
+              </Text>
+              <Wrap>
+                {contact.commonArtists.map((artist) => (
+                  <WrapItem key={artist.id}>
+                    <Tag size="lg" colorScheme="green">
+                      {artist.name}
+                    </Tag>
+                  </WrapItem>
+                ))}
+              </Wrap>
+            </Box>
+          )}
+
+          {contactStatus === 'CONTACT_STATUS_REVEALED' && (
+            <Alert status="success" borderRadius="md" flexDirection="column" alignItems="start">
+              <AlertTitle mb={2}>You both want to connect!</AlertTitle>
+              <AlertDescription>
+                <Text>Email: {contact.partnerEmail}</Text>
+                <Text>Phone: {contact.partnerPhone || 'Not provided'}</Text>
+              </AlertDescription>
+            </Alert>
+          )}
+
+          {contactStatus === 'CONTACT_STATUS_DECLINED' && (
+            <Alert status="info" borderRadius="md">
+              <AlertIcon />
+              <AlertDescription>
+                {contact.accepted
+                  ? `${contact.partnerFirstName} decided not to connect this time, so no contact details were shared.`
+                  : 'You chose not to connect, so no contact details were shared. You can still change your mind.'}
+              </AlertDescription>
+            </Alert>
+          )}
+
+          {contactStatus === 'CONTACT_STATUS_WAITING' && (
+            <Alert status="info" borderRadius="md">
+              <AlertIcon />
+              <AlertDescription>
+                Thanks! We'll share your contact details with each other as soon as {contact.partnerFirstName} says yes too.
+              </AlertDescription>
+            </Alert>
+          )}
+
+          {error && (
+            <Alert status="error" borderRadius="md">
+              <AlertIcon />
+              <AlertDescription>{error}</AlertDescription>
+            </Alert>
+          )}
+
+          {contactStatus !== 'CONTACT_STATUS_REVEALED' && (
+            <Box textAlign="center">
+              <Text mb={4}>
+                Would you like to share your contact details with {contact.partnerFirstName}? They are only shared once you both say yes.
+              </Text>
+              <HStack spacing={4} justify="center">
+                <Button
+                  colorScheme="spotifygreen"
+                  size="lg"
+                  variant={suggestedAnswer === 'decline' ? 'outline' : 'solid'}
+                  isLoading={status === 'accepting'}
+                  isDisabled={status !== 'idle' || contact.accepted}
+                  onClick={() => handleRespond(true)}
+                >
+                  Yes, let's connect
+                </Button>
+                <Button
+                  size="lg"
+                  variant={suggestedAnswer === 'decline' ? 'solid' : 'outline'}
+                  isLoading={status === 'declining'}
+                  isDisabled={status !== 'idle' || (contactStatus === 'CONTACT_STATUS_DECLINED' && !contact.accepted)}
+                  onClick={() => handleRespond(false)}
+                >
+                  No thanks
+                </Button>
+              </HStack>
+            </Box>
+          )}
+        </VStack>
+      </Box>
+    </Box>
+  );
+}
+
+export default MatchResponse;
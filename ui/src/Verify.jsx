@@ -0,0 +1,179 @@
+import { useEffect, useRef, useState } from 'react';
+import { useNavigate, useLocation } from 'react-router-dom';
+import {
+  Box,
+  Heading,
+  Text,
+  Alert,
+  AlertIcon,
+  AlertTitle,
+  AlertDescription,
+  VStack,
+  Button,
+  Spinner,
+  Input,
+  Tag,
+  Wrap,
+  WrapItem,
+} from '@chakra-ui/react';
+import { verifyEmail, resendVerification } from './utils/api';
+
+function Verify() {
+  const [result, setResult] = useState(null);
+  const [status, setStatus] = useState('loading');
+  const [error, setError] = useState(null);
+  const [email, setEmail] = useState('');
+  const [resendStatus, setResendStatus] = useState('idle');
+  const [resendError, setResendError] = useState(null);
+  const verified = useRef(false);
+  const navigate = useNavigate();
+  const location = useLocation();
+
+  const verifyToken = new URLSearchParams(location.search).get('token');
+
+  useEffect(() => {
+    // Links can only be used once, so don't verify again when the effect reruns
+    if (!verifyToken || verified.current) {
+      return;
+    }
+    verified.current = true;
+    verifyEmail(verifyToken)
+      .then((data) => {
+        setResult(data);
+        setStatus('verified');
+      })
+      .catch((err) => {
+        setError(err.message);
+        setStatus('failed');
+      });
+  }, [verifyToken]);
+
+  const handleResend = async () => {
+    setResendStatus('sending');
+    setResendError(null);
+    try {
+      await resendVerification(email);
+      setResendStatus('sent');
+    } catch (err) {
+      setResendError(err.message);
+      setResendStatus('idle');
+    }
+  };
+
+  const handleGoHome = () => {
+    navigate('/');
+  };
+
+  if (verifyToken && status === 'loading') {
+    return (
+      <Box bg="#faf0e6" height="100vh" display="flex" justifyContent="center" alignItems="center">
+        <Spinner size="xl" />
+      </Box>
+    );
+  }
+
+  if (status === 'verified') {
+    return (
+      <Box bg="#faf0e6" minHeight="100vh" py={8}>
+        <Box
+          minWidth="350px"
+          maxWidth="600px"
+          mx="auto"
+          p={8}
+          bg="#fafcff"
+          borderRadius="lg"
+          boxShadow="md"
+          w="60%"
+        >
+          <VStack spacing={6} align="stretch">
+            <Box textAlign="center">
+              <Heading as="h1" size="xl" mb={2}>
+                You're in!
+              </Heading>
+              <Text fontSize="lg">Your email is confirmed. You will be matched soon!</Text>
+            </Box>
+
+            {result.uniqueArtists?.length > 0 && (
+              <Box>
+                <Text fontWeight="bold" mb={2}>
+                  Your artists
+                </Text>
+                <Wrap>
+                  {result.uniqueArtists.map((artist) => (
+                    <WrapItem key={artist.id}>
+                      <Tag size="lg" colorScheme="green">
+                        {artist.name}
+                      </Tag>
+                    </WrapItem>
+                  ))}
+                </Wrap>
+              </Box>
+            )}
+
+            <Box textAlign="center">
+              <Button colorScheme="spotifygreen" onClick={handleGoHome}>
+                Go Back Home
+              </Button>
+            </Box>
+          </VStack>
+        </Box>
+      </Box>
+    );
+  }
+
+  return (
+    <Box bg="#faf0e6" height="100vh" display="flex" justifyContent="center" alignItems="center" p={4}>
+      <Alert
+        status="error"
+        variant="subtle"
+        flexDirection="column"
+        alignItems="center"
+        justifyContent="center"
+        textAlign="center"
+        height="auto"
+        borderRadius="lg"
+        p={6}
+      >
+        <AlertIcon boxSize="40px" mr={0} />
+        <AlertTitle mt={4} mb={1} fontSize="lg">
+          {verifyToken ? 'Could not confirm your email' : 'Missing verification link'}
+        </AlertTitle>
+        <AlertDescription maxWidth="sm">
+          {verifyToken ? error : 'Please use the link from your verification email.'}
+        </AlertDescription>
+
+        {resendStatus === 'sent' ? (
+          <Text mt={4} maxWidth="sm">
+            If you have a pending signup, a new link is on its way to {email}.
+          </Text>
+        ) : (
+          <VStack mt={4} spacing={2} maxWidth="sm" w="100%">
+            <Text>Enter the email you signed up with to get a new link:</Text>
+            <Input
+              type="email"
+              bg="white"
+              placeholder="you@example.com"
+              value={email}
+              onChange={(e) => setEmail(e.target.value)}
+            />
+            {resendError && <Text color="red.600">{resendError}</Text>}
+            <Button
+              colorScheme="spotifygreen"
+              isLoading={resendStatus === 'sending'}
+              isDisabled={!email}
+              onClick={handleResend}
+            >
+              Send a New Link
+            </Button>
+          </VStack>
+        )}
+
+        <Button mt={4} variant="outline" onClick={handleGoHome}>
+          Go Back Home
+        </Button>
+      </Alert>
+    </Box>
+  );
+}
+
+export default Verify;
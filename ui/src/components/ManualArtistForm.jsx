@@ -20,6 +20,7 @@ const ManualArtistForm = ({
   handleChange, 
   validateForm, 
   isLimitReached,
+  sides,
   selectedArtists,
   setSelectedArtists,
   fetchUserCount,
@@ -117,22 +118,36 @@ const ManualArtistForm = ({
       const artistIds = selectedArtists.map(artist => artist.id);
       
       // Submit to backend
-      await saveUserSelectedArtists({
+      const result = await saveUserSelectedArtists({
         firstName: formData.firstName,
         lastName: formData.lastName,
         email: formData.email,
         phoneNumber: formData.phoneNumber,
+        discovery: formData.discovery,
+        textMe: formData.textMe,
+        side: formData.side,
         artistIds: artistIds
       });
       
-      // Success message
-      toast({
-        title: 'Submission Successful',
-        description: 'Your artist preferences have been saved. You will be matched soon!',
-        status: 'success',
-        duration: 5000,
-        isClosable: true,
-      });
+      // Success message. Signups that need their email verified only count
+      // once the link in the verification email is clicked.
+      if (result.verificationPending) {
+        toast({
+          title: 'Check Your Email',
+          description: `We sent a link to ${formData.email}. Click it within 24 hours to join this round.`,
+          status: 'info',
+          duration: 10000,
+          isClosable: true,
+        });
+      } else {
+        toast({
+          title: 'Submission Successful',
+          description: 'Your artist preferences have been saved. You will be matched soon!',
+          status: 'success',
+          duration: 5000,
+          isClosable: true,
+        });
+      }
       
       // Update user count after successful submission
       fetchUserCount();
@@ -163,6 +178,7 @@ const ManualArtistForm = ({
             formData={formData}
             errors={errors}
             handleChange={handleChange}
+            sides={sides}
             formType="manual"
           />
           
@@ -8,7 +8,7 @@ import UserInfoForm from './UserInfoForm';
 import { MdMusicNote } from "react-icons/md";
 import { getSpotifyAuthUrl } from '../utils/api';
 
-const SpotifyConnectForm = ({ formData, errors, handleChange, validateForm, isLimitReached }) => {
+const SpotifyConnectForm = ({ formData, errors, handleChange, validateForm, isLimitReached, sides }) => {
   const toast = useToast();
 
   const handleSubmit = async (e) => {
@@ -67,6 +67,7 @@ const SpotifyConnectForm = ({ formData, errors, handleChange, validateForm, isLi
           formData={formData}
           errors={errors}
           handleChange={handleChange}
+          sides={sides}
           formType="spotify"
         />
         
@@ -3,10 +3,12 @@ import {
   FormControl, 
   FormLabel, 
   Input, 
-  FormErrorMessage 
+  FormErrorMessage,
+  Checkbox,
+  Select
 } from '@chakra-ui/react';
 
-const UserInfoForm = ({ formData, errors, handleChange, formType = '' }) => {
+const UserInfoForm = ({ formData, errors, handleChange, sides = [], formType = '' }) => {
   // The formType parameter allows us to add a suffix to input IDs to avoid conflicts
   // when both forms are rendered on the same page
   const idSuffix = formType ? `-${formType}` : '';
@@ -66,6 +68,48 @@ const UserInfoForm = ({ formData, errors, handleChange, formType = '' }) => {
         />
         <FormErrorMessage>{errors.phoneNumber}</FormErrorMessage>
       </FormControl>
+
+      {/* Rounds matched across two groups ask which one the user belongs to */}
+      {sides.length > 0 && (
+        <FormControl isInvalid={errors.side}>
+          <FormLabel htmlFor={`side${idSuffix}`}>Group</FormLabel>
+          <Select
+            id={`side${idSuffix}`}
+            name="side"
+            value={formData.side}
+            onChange={handleChange}
+            variant='flushed'
+            placeholder="Choose your group"
+          >
+            {sides.map(side => (
+              <option key={side} value={side}>{side}</option>
+            ))}
+          </Select>
+          <FormErrorMessage>{errors.side}</FormErrorMessage>
+        </FormControl>
+      )}
+
+      <FormControl>
+        <Checkbox
+          id={`discovery${idSuffix}`}
+          name="discovery"
+          isChecked={formData.discovery}
+          onChange={handleChange}
+        >
+          Match me with someone who can introduce me to new music
+        </Checkbox>
+      </FormControl>
+
+      <FormControl>
+        <Checkbox
+          id={`textMe${idSuffix}`}
+          name="textMe"
+          isChecked={formData.textMe}
+          onChange={handleChange}
+        >
+          Also text me about my match
+        </Checkbox>
+      </FormControl>
     </>
   );
 };
@@ -6,15 +6,15 @@ const useFormValidation = (initialState) => {
 
   // Handle input changes
   const handleChange = (e) => {
-    const { name, value } = e.target;
+    const { name, value, type, checked } = e.target;
     setFormData({
       ...formData,
-      [name]: value
+      [name]: type === 'checkbox' ? checked : value
     });
   };
 
-  // Validate form
-  const validateForm = () => {
+  // Validate form, requiring one of the round's sides when it has any
+  const validateForm = (sides = []) => {
     const newErrors = {};
     
     // Validate first name
@@ -37,6 +37,13 @@ const useFormValidation = (initialState) => {
     // Validate phone number (optional but must be valid if provided)
     if (formData.phoneNumber && !/^\d{10}$/.test(formData.phoneNumber.replace(/\D/g, ''))) {
       newErrors.phoneNumber = 'Phone number must be 10 digits';
+    } else if (formData.textMe && !formData.phoneNumber) {
+      newErrors.phoneNumber = 'Phone number is required to get texts';
+    }
+
+    // Validate side
+    if (sides.length > 0 && !sides.includes(formData.side)) {
+      newErrors.side = 'Please choose a group';
     }
     
     setErrors(newErrors);
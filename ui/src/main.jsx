@@ -4,6 +4,9 @@ import { BrowserRouter, Routes, Route } from 'react-router-dom'
 import { ChakraProvider, extendTheme } from '@chakra-ui/react'
 import App from './App'
 import Callback from './Callback'
+import Feedback from './Feedback'
+import MatchResponse from './MatchResponse'
+import Verify from './Verify'
 
 // Create a custom theme with Satoshi font
 const theme = extendTheme({
@@ -38,6 +41,9 @@ ReactDOM.createRoot(document.getElementById('root')).render(
         <Routes>
           <Route path="/" element={<App />} />
           <Route path="/callback" element={<Callback />} />
+          <Route path="/feedback" element={<Feedback />} />
+          <Route path="/match" element={<MatchResponse />} />
+          <Route path="/verify" element={<Verify />} />
         </Routes>
       </BrowserRouter>
     </ChakraProvider>
@@ -2,7 +2,7 @@
 
 /**
  * Fetch the current user count from the API
- * @returns {Promise<{count: number, maxUsers: number}>}
+ * @returns {Promise<{count: number, maxUsers: number, sides: string[]}>}
  */
 export const fetchUserCount = async () => {
   try {
@@ -21,7 +21,8 @@ export const fetchUserCount = async () => {
     const data = await response.json();
     return {
       count: data.count,
-      maxUsers: data.maxUsers
+      maxUsers: data.maxUsers,
+      sides: data.sides || []
     };
   } catch (error) {
     console.error('Error fetching user count:', error);
@@ -54,6 +55,15 @@ export const getSpotifyAuthUrl = async () => {
   }
 };
 
+/**
+ * Get the channels a user wants to be notified on from their signup form
+ * @param {Object} userData - User data from the signup form
+ * @param {boolean} userData.textMe - Whether the user also wants to be notified by text
+ * @returns {string[]} - Notification channels
+ */
+export const notificationChannels = (userData) =>
+  userData.textMe ? ['email', 'sms'] : ['email'];
+
 /**
  * Save user selected artists
  * @param {Object} userData - User data including artist selections
@@ -61,6 +71,9 @@ export const getSpotifyAuthUrl = async () => {
  * @param {string} userData.lastName - User's last name
  * @param {string} userData.email - User's email
  * @param {string} userData.phoneNumber - User's phone number (optional)
+ * @param {boolean} userData.discovery - Whether the user wants a partner who can broaden their listening
+ * @param {string} userData.side - Side of the round the user belongs to, if the round has sides
+ * @param {boolean} userData.textMe - Whether the user also wants to be notified by text
  * @param {string[]} userData.artistIds - Array of selected artist IDs
  * @returns {Promise<Object>} - Response from the API
  */
@@ -76,6 +89,9 @@ export const saveUserSelectedArtists = async (userData) => {
         lastName: userData.lastName,
         email: userData.email,
         number: userData.phoneNumber,
+        discovery: userData.discovery,
+        side: userData.side,
+        notificationChannels: notificationChannels(userData),
         artistIds: userData.artistIds
       }),
     });
@@ -128,3 +144,139 @@ export const searchArtists = async (query, limit = 10, offset = 0) => {
     throw error;
   }
 };
+
+/**
+ * Submit feedback on a match
+ * @param {Object} feedback - Feedback on the match
+ * @param {string} feedback.feedbackToken - Token from the feedback link in the match email
+ * @param {boolean} feedback.connected - Whether the user got in touch with their match
+ * @param {number} feedback.rating - Rating of the match from 1 to 5
+ * @param {string} feedback.comment - Optional comment
+ * @returns {Promise<Object>} - Response from the API
+ */
+export const submitMatchFeedback = async (feedback) => {
+  try {
+    const response = await fetch('/api/spotify.v1.SpotifyService/SubmitMatchFeedback', {
+      method: 'POST',
+      headers: {
+        'Content-Type': 'application/json',
+      },
+      body: JSON.stringify({
+        feedbackToken: feedback.feedbackToken,
+        connected: feedback.connected,
+        rating: feedback.rating,
+        comment: feedback.comment
+      }),
+    });
+    
+    if (!response.ok) {
+      const errorData = await response.json();
+      throw new Error(errorData.message || 'Failed to submit feedback');
+    }
+    
+    return await response.json();
+  } catch (error) {
+    console.error('Error submitting match feedback:', error);
+    throw error;
+  }
+};
+
+/**
+ * Gets the match a response link was sent for, with the partner's contact
+ * details once both users accepted
+ * @param {string} responseToken - Token from the match email's response link
+ * @returns {Promise<Object>} - The match contact from the API
+ */
+export const getMatchContact = async (responseToken) => {
+  try {
+    const response = await fetch('/api/spotify.v1.SpotifyService/GetMatchContact', {
+      method: 'POST',
+      headers: {
+        'Content-Type': 'application/json',
+      },
+      body: JSON.stringify({ responseToken }),
+    });
+
+    if (!response.ok) {
+      const errorData = await response.json();
+      throw new Error(errorData.message || 'Failed to load match');
+    }
+
+    const data = await response.json();
+    return data.contact;
+  } catch (error) {
+    console.error('Error getting match contact:', error);
+    throw error;
+  }
+};
+
+/**
+ * Accepts or declines sharing contact details with a match
+ * @param {string} responseToken - Token from the match email's response link
+ * @param {boolean} accept - Whether to share contact details
+ * @returns {Promise<Object>} - The updated match contact from the API
+ */
+export const respondToMatch = async (responseToken, accept) => {
+  try {
+    const response = await fetch('/api/spotify.v1.SpotifyService/RespondToMatch', {
+      method: 'POST',
+      headers: {
+        'Content-Type': 'application/json',
+      },
+      body: JSON.stringify({ responseToken, accept }),
+    });
+
+    if (!response.ok) {
+      const errorData = await response.json();
+      throw new Error(errorData.message || 'Failed to respond to match');
+    }
+
+    const data = await response.json();
+    return data.contact;
+  } catch (error) {
+    console.error('Error responding to match:', error);
+    throw error;
+  }
+};
+
+export const verifyEmail = async (token) => {
+  try {
+    const response = await fetch('/api/spotify.v1.SpotifyService/VerifyEmail', {
+      method: 'POST',
+      headers: {
+        'Content-Type': 'application/json',
+      },
+      body: JSON.stringify({ token }),
+    });
+
+    if (!response.ok) {
+      const errorData = await response.json();
+      throw new Error(errorData.message || 'Failed to verify email');
+    }
+
+    return await response.json();
+  } catch (error) {
+    console.error('Error verifying email:', error);
+    throw error;
+  }
+};
+
+export const resendVerification = async (email) => {
+  try {
+    const response = await fetch('/api/spotify.v1.SpotifyService/ResendVerification', {
+      method: 'POST',
+      headers: {
+        'Content-Type': 'application/json',
+      },
+      body: JSON.stringify({ email }),
+    });
+
+    if (!response.ok) {
+      const errorData = await response.json();
+      throw new Error(errorData.message || 'Failed to resend verification email');
+    }
+  } catch (error) {
+    console.error('Error resending verification email:', error);
+    throw error;
+  }
+};